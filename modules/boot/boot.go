@@ -29,6 +29,7 @@ import (
 	"github.com/polynetwork/zion-example/modules/info_sync"
 	"github.com/polynetwork/zion-example/modules/node_manager"
 	"github.com/polynetwork/zion-example/modules/proposal_manager"
+	"github.com/polynetwork/zion-example/modules/relayer_manager"
 	"github.com/polynetwork/zion-example/modules/side_chain_manager"
 )
 
@@ -40,6 +41,7 @@ func init() {
 	params.RegisterModuleContractAddrMap(cfg.ModuleCrossChain, cfg.CrossChainManagerContractAddress)
 	params.RegisterModuleContractAddrMap(cfg.ModuleSideChainManager, cfg.SideChainManagerContractAddress)
 	params.RegisterModuleContractAddrMap(cfg.ModuleProposalManager, cfg.ProposalManagerContractAddress)
+	params.RegisterModuleContractAddrMap(cfg.ModuleRelayerManager, cfg.RelayerManagerContractAddress)
 
 	//set genesis state of module contract when init genesis
 	core.RegGenesis = node_manager.SetupGenesis
@@ -57,6 +59,7 @@ func InitModuleContracts() {
 	cross_chain_manager.InitCrossChainManager()
 	side_chain_manager.InitSideChainManager()
 	proposal_manager.InitProposalManager()
+	relayer_manager.InitRelayerManager()
 
 	log.Info("Initialize module contracts",
 		"node manager", cfg.NodeManagerContractAddress.Hex(),
@@ -65,5 +68,6 @@ func InitModuleContracts() {
 		"cross chain manager", cfg.CrossChainManagerContractAddress.Hex(),
 		"side chain manager", cfg.SideChainManagerContractAddress.Hex(),
 		"proposal manager", cfg.ProposalManagerContractAddress.Hex(),
+		"relayer manager", cfg.RelayerManagerContractAddress.Hex(),
 	)
 }
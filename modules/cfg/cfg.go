@@ -27,6 +27,7 @@ const (
 	ModuleCrossChain       = "cross_chain"
 	ModuleSideChainManager = "side_chain_manager"
 	ModuleProposalManager  = "proposal_manager"
+	ModuleRelayerManager   = "relayer_manager"
 )
 
 var (
@@ -36,4 +37,5 @@ var (
 	CrossChainManagerContractAddress = common.HexToAddress("0x0000000000000000000000000000000000001003")
 	SideChainManagerContractAddress  = common.HexToAddress("0x0000000000000000000000000000000000001004")
 	ProposalManagerContractAddress   = common.HexToAddress("0x0000000000000000000000000000000000001005")
+	RelayerManagerContractAddress    = common.HexToAddress("0x0000000000000000000000000000000000001006")
 )
@@ -18,8 +18,10 @@
 package common
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -31,14 +33,21 @@ import (
 )
 
 var (
-	MethodContractName        = cross_chain_manager_abi.MethodName
-	MethodImportOuterTransfer = cross_chain_manager_abi.MethodImportOuterTransfer
-	MethodMultiSignRipple     = cross_chain_manager_abi.MethodMultiSignRipple
-	MethodReconstructRippleTx = cross_chain_manager_abi.MethodReconstructRippleTx
-	MethodCheckDone           = cross_chain_manager_abi.MethodCheckDone
-	MethodBlackChain          = cross_chain_manager_abi.MethodBlackChain
-	MethodWhiteChain          = cross_chain_manager_abi.MethodWhiteChain
-	MethodReplenish           = cross_chain_manager_abi.MethodReplenish
+	MethodContractName          = cross_chain_manager_abi.MethodName
+	MethodImportOuterTransfer   = cross_chain_manager_abi.MethodImportOuterTransfer
+	MethodImportOuterTransfer64 = cross_chain_manager_abi.MethodImportOuterTransfer64
+	MethodMultiSignRipple       = cross_chain_manager_abi.MethodMultiSignRipple
+	MethodReconstructRippleTx   = cross_chain_manager_abi.MethodReconstructRippleTx
+	MethodFlushRippleBatch      = cross_chain_manager_abi.MethodFlushRippleBatch
+	MethodMultiSignBatch        = cross_chain_manager_abi.MethodMultiSignRippleBatch
+	MethodConfirmRippleTx       = cross_chain_manager_abi.MethodConfirmRippleTx
+	MethodGetRippleTxStatus     = cross_chain_manager_abi.MethodGetRippleTxStatus
+	MethodGetRippleVault        = cross_chain_manager_abi.MethodGetRippleVault
+	MethodReconcileRippleVault  = cross_chain_manager_abi.MethodReconcileRippleVault
+	MethodCheckDone             = cross_chain_manager_abi.MethodCheckDone
+	MethodBlackChain            = cross_chain_manager_abi.MethodBlackChain
+	MethodWhiteChain            = cross_chain_manager_abi.MethodWhiteChain
+	MethodReplenish             = cross_chain_manager_abi.MethodReplenish
 )
 
 var ABI *abi.ABI
@@ -79,7 +88,18 @@ func (m *CheckDoneOutput) Decode(payload []byte) error {
 	return nil
 }
 
+// EntranceParam is the input of importOuterTransfer and importOuterTransfer64, heights
+// encode the same in rlp at either width so digests do not depend on the method used
 type EntranceParam struct {
+	SourceChainID uint64
+	Height        uint64
+	Proof         []byte
+	Extra         []byte
+	Signature     []byte
+}
+
+// entranceParam32 is the input of importOuterTransfer
+type entranceParam32 struct {
 	SourceChainID uint64
 	Height        uint32
 	Proof         []byte
@@ -87,8 +107,29 @@ type EntranceParam struct {
 	Signature     []byte
 }
 
+// Encode packs heights that fit in uint32 for importOuterTransfer and others for importOuterTransfer64
 func (m *EntranceParam) Encode() ([]byte, error) {
-	return contract.PackMethodWithStruct(ABI, MethodImportOuterTransfer, m)
+	if m.Height > math.MaxUint32 {
+		return contract.PackMethodWithStruct(ABI, MethodImportOuterTransfer64, m)
+	}
+	return contract.PackMethodWithStruct(ABI, MethodImportOuterTransfer,
+		&entranceParam32{m.SourceChainID, uint32(m.Height), m.Proof, m.Extra, m.Signature})
+}
+
+// UnpackEntranceParam unpacks the payload of importOuterTransfer or importOuterTransfer64
+func UnpackEntranceParam(payload []byte) (*EntranceParam, error) {
+	if len(payload) >= 4 && bytes.Equal(payload[:4], ABI.Methods[MethodImportOuterTransfer64].ID) {
+		params := &EntranceParam{}
+		if err := contract.UnpackMethod(ABI, MethodImportOuterTransfer64, params, payload); err != nil {
+			return nil, err
+		}
+		return params, nil
+	}
+	params := &entranceParam32{}
+	if err := contract.UnpackMethod(ABI, MethodImportOuterTransfer, params, payload); err != nil {
+		return nil, err
+	}
+	return &EntranceParam{params.SourceChainID, uint64(params.Height), params.Proof, params.Extra, params.Signature}, nil
 }
 
 func (m *EntranceParam) EncodeRLP(w io.Writer) error {
@@ -97,7 +138,7 @@ func (m *EntranceParam) EncodeRLP(w io.Writer) error {
 func (m *EntranceParam) DecodeRLP(s *rlp.Stream) error {
 	var data struct {
 		SourceChainID uint64
-		Height        uint32
+		Height        uint64
 		Proof         []byte
 		Extra         []byte
 		Signature     []byte
@@ -205,6 +246,65 @@ func (m *ReconstructTxParam) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
+type FlushRippleBatchParam struct {
+	ToChainId uint64
+}
+
+func (m *FlushRippleBatchParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodFlushRippleBatch, m)
+}
+
+type MultiSignBatchParam struct {
+	ToChainId uint64
+	BatchId   uint64
+	TxJsons   []string
+}
+
+func (m *MultiSignBatchParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodMultiSignBatch, m)
+}
+
+// ConfirmRippleTxParam is the ledger result of a payment attested by voters, Delivered and Fee are in drops
+type ConfirmRippleTxParam struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      []byte
+	Result      string
+	Delivered   uint64
+	Fee         uint64
+}
+
+func (m *ConfirmRippleTxParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodConfirmRippleTx, m)
+}
+
+type RippleTxStatusParam struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      []byte
+}
+
+func (m *RippleTxStatusParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetRippleTxStatus, m)
+}
+
+type RippleVaultParam struct {
+	ChainId uint64
+}
+
+func (m *RippleVaultParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetRippleVault, m)
+}
+
+type ReconcileRippleVaultParam struct {
+	ChainId uint64
+	Balance uint64
+}
+
+func (m *ReconcileRippleVaultParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodReconcileRippleVault, m)
+}
+
 type BlackChainParam struct {
 	ChainID uint64
 }
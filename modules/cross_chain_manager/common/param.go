@@ -18,6 +18,7 @@
 package common
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -25,10 +26,19 @@ import (
 )
 
 const (
-	REQUEST        = "request"
-	DONE_TX        = "doneTx"
-	MULTISIGN_INFO = "multisignInfo"
-	RIPPLE_TX_INFO = "rippleTxInfo"
+	REQUEST           = "request"
+	DONE_TX           = "doneTx"
+	MULTISIGN_INFO    = "multisignInfo"
+	RIPPLE_TX_INFO    = "rippleTxInfo"
+	RIPPLE_BATCH      = "rippleBatch"
+	RIPPLE_BATCH_INFO = "rippleBatchInfo"
+	RIPPLE_VAULT      = "rippleVault"
+	RIPPLE_PAYMENT    = "ripplePayment"
+	RIPPLE_TX_RESULT  = "rippleTxResult"
+	RIPPLE_TX_PARAM   = "rippleTxParam"
+	REPLENISH_TX      = "replenishTx"
+	REPLENISH_QUEUE   = "replenishQueue"
+	REPLENISH_REQUEST = "replenishRequest"
 
 	NOTIFY_MAKE_PROOF_EVENT = "makeProof"
 	REPLENISH_EVENT         = "ReplenishEvent"
@@ -131,6 +141,18 @@ func EncodeTxParam(param *MakeTxParam) (data []byte, err error) {
 	return
 }
 
+// ReplenishRequest is the tx hashes a replenish call requested at a block, queued until their records expire
+type ReplenishRequest struct {
+	Height   uint64
+	TxHashes []string
+}
+
+// ReplenishQueue is the range [Head, Tail) of the queued replenish requests of a chain
+type ReplenishQueue struct {
+	Head uint64
+	Tail uint64
+}
+
 type ToMerkleValue struct {
 	TxHash      []byte
 	FromChainID uint64
@@ -138,42 +160,127 @@ type ToMerkleValue struct {
 }
 
 type RippleTxArgs struct {
-	ToAddress []byte
-	Amount    *big.Int
+	ToAddress      []byte
+	Amount         *big.Int
+	DestinationTag *uint32      `rlp:"nil,optional"`
+	Memos          []RippleMemo `rlp:"optional"`
+	// the account of the source chain a refused payment is refunded to
+	Sender []byte `rlp:"optional"`
 }
 
-func DecodeRippleTxArgs(data []byte) (param *RippleTxArgs, err error) {
+type RippleMemo struct {
+	MemoType   []byte
+	MemoData   []byte
+	MemoFormat []byte
+}
+
+// used for ripple tx args carrying destination tag, memos or sender
+type RippleTxArgsShim struct {
+	ToAddress         []byte
+	Amount            *big.Int
+	HasDestinationTag bool
+	DestinationTag    uint32
+	Memos             []RippleMemo
+	Sender            []byte
+}
+
+func rippleTxArgsBasic() abi.Arguments {
 	BytesTy, _ := abi.NewType("bytes", "", nil)
 	IntTy, _ := abi.NewType("int", "", nil)
-	// StringTy, _ := abi.NewType("string", "", nil)
 
-	Args := abi.Arguments{
+	return abi.Arguments{
 		{Type: BytesTy, Name: "toAddress"},
 		{Type: IntTy, Name: "amount"},
 	}
+}
 
+func rippleTxArgsExtended() abi.Arguments {
+	BytesTy, _ := abi.NewType("bytes", "", nil)
+	BoolTy, _ := abi.NewType("bool", "", nil)
+	Uint32Ty, _ := abi.NewType("uint32", "", nil)
+	MemosTy, _ := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "memoType", Type: "bytes"},
+		{Name: "memoData", Type: "bytes"},
+		{Name: "memoFormat", Type: "bytes"},
+	})
+
+	return append(rippleTxArgsBasic(),
+		abi.Argument{Type: BoolTy, Name: "hasDestinationTag"},
+		abi.Argument{Type: Uint32Ty, Name: "destinationTag"},
+		abi.Argument{Type: MemosTy, Name: "memos"},
+		abi.Argument{Type: BytesTy, Name: "sender"},
+	)
+}
+
+// DecodeRippleTxArgs accepts both the plain (toAddress, amount) layout and the
+// extended layout carrying destination tag, memos and sender.
+func DecodeRippleTxArgs(data []byte) (param *RippleTxArgs, err error) {
+	Args := rippleTxArgsBasic()
 	args, err := Args.Unpack(data)
 	if err != nil {
 		return
 	}
-
 	param = new(RippleTxArgs)
 	err = Args.Copy(param, args)
 	if err != nil {
 		return nil, err
 	}
+	basic, err := Args.Pack(param.ToAddress, param.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(basic, data) {
+		return
+	}
+
+	Args = rippleTxArgsExtended()
+	args, err = Args.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	shim := new(RippleTxArgsShim)
+	err = Args.Copy(shim, args)
+	if err != nil {
+		return nil, err
+	}
+	param = &RippleTxArgs{
+		ToAddress: shim.ToAddress,
+		Amount:    shim.Amount,
+		Memos:     shim.Memos,
+		Sender:    shim.Sender,
+	}
+	if shim.HasDestinationTag {
+		tag := shim.DestinationTag
+		param.DestinationTag = &tag
+	}
 	return
 }
 
+// EncodeRippleTxArgs keeps the plain (toAddress, amount) layout when none of
+// destination tag, memos and sender is set.
 func EncodeRippleTxArgs(args *RippleTxArgs) (data []byte, err error) {
-	BytesTy, _ := abi.NewType("bytes", "", nil)
-	IntTy, _ := abi.NewType("int", "", nil)
-
-	Args := abi.Arguments{
-		{Type: BytesTy, Name: "toAddress"},
-		{Type: IntTy, Name: "amount"},
+	if args.DestinationTag == nil && len(args.Memos) == 0 && len(args.Sender) == 0 {
+		data, err = rippleTxArgsBasic().Pack(args.ToAddress, args.Amount)
+		return
 	}
 
-	data, err = Args.Pack(args.ToAddress, args.Amount)
+	shim := &RippleTxArgsShim{
+		ToAddress: args.ToAddress,
+		Amount:    args.Amount,
+		Memos:     args.Memos,
+		Sender:    args.Sender,
+	}
+	if args.DestinationTag != nil {
+		shim.HasDestinationTag = true
+		shim.DestinationTag = *args.DestinationTag
+	}
+	if shim.Memos == nil {
+		shim.Memos = []RippleMemo{}
+	}
+	if shim.Sender == nil {
+		shim.Sender = []byte{}
+	}
+	data, err = rippleTxArgsExtended().Pack(shim.ToAddress, shim.Amount, shim.HasDestinationTag, shim.DestinationTag,
+		shim.Memos, shim.Sender)
 	return
 }
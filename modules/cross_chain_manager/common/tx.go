@@ -31,10 +31,15 @@ import (
 )
 
 func MakeTransaction(service *contract.ModuleContract, params *MakeTxParam, fromChainID uint64) error {
-
 	txHash := service.ContractRef().TxHash()
+	return MakeTransactionWithHash(service, txHash[:], params, fromChainID)
+}
+
+// MakeTransactionWithHash stores the request under the given hash, so one zion transaction
+// can make several requests to the same chain
+func MakeTransactionWithHash(service *contract.ModuleContract, txHash []byte, params *MakeTxParam, fromChainID uint64) error {
 	merkleValue := &ToMerkleValue{
-		TxHash:      txHash[:],
+		TxHash:      txHash,
 		FromChainID: fromChainID,
 		MakeTxParam: params,
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
 )
 
@@ -84,3 +85,111 @@ func doneTxKey(chainID uint64, crossChainID []byte) []byte {
 	contractAddr := cfg.CrossChainManagerContractAddress
 	return utils.ConcatKey(contractAddr, []byte(DONE_TX), utils.GetUint64Bytes(chainID), crossChainID)
 }
+
+// GetReplenishTx returns the block a tx hash was last requested to be replenished at
+func GetReplenishTx(module *contract.ModuleContract, txHash string, chainID uint64) (uint64, bool, error) {
+	value, err := module.GetCacheDB().Get(replenishTxKey(chainID, txHash))
+	if err != nil {
+		return 0, false, fmt.Errorf("GetReplenishTx, module.GetCacheDB().Get error: %v", err)
+	}
+	if value == nil {
+		return 0, false, nil
+	}
+	return utils.GetBytesUint64(value), true, nil
+}
+
+func PutReplenishTx(module *contract.ModuleContract, txHash string, chainID uint64, blockHeight uint64) error {
+	return module.GetCacheDB().Put(replenishTxKey(chainID, txHash), utils.GetUint64Bytes(blockHeight))
+}
+
+// PushReplenishRequest queues the tx hashes requested by a replenish call so their records can expire
+func PushReplenishRequest(module *contract.ModuleContract, chainID uint64, request *ReplenishRequest) error {
+	queue, err := getReplenishQueue(module, chainID)
+	if err != nil {
+		return fmt.Errorf("PushReplenishRequest, %v", err)
+	}
+	blob, err := rlp.EncodeToBytes(request)
+	if err != nil {
+		return fmt.Errorf("PushReplenishRequest, rlp.EncodeToBytes request error: %v", err)
+	}
+	if err := module.GetCacheDB().Put(replenishRequestKey(chainID, queue.Tail), blob); err != nil {
+		return fmt.Errorf("PushReplenishRequest, put request error: %v", err)
+	}
+	queue.Tail++
+	return putReplenishQueue(module, chainID, queue)
+}
+
+// ExpireReplenishTxs deletes the records of at most limit queued replenish requests made window blocks before
+// current or earlier, a record is kept if its tx hash was requested again since
+func ExpireReplenishTxs(module *contract.ModuleContract, chainID, current, window uint64, limit int) error {
+	queue, err := getReplenishQueue(module, chainID)
+	if err != nil {
+		return fmt.Errorf("ExpireReplenishTxs, %v", err)
+	}
+	head := queue.Head
+	for ; head < queue.Tail && int(head-queue.Head) < limit; head++ {
+		store, err := module.GetCacheDB().Get(replenishRequestKey(chainID, head))
+		if err != nil {
+			return fmt.Errorf("ExpireReplenishTxs, get request error: %v", err)
+		}
+		request := new(ReplenishRequest)
+		if err := rlp.DecodeBytes(store, request); err != nil {
+			return fmt.Errorf("ExpireReplenishTxs, deserialize request error: %v", err)
+		}
+		if request.Height+window > current {
+			break
+		}
+		for _, txHash := range request.TxHashes {
+			last, ok, err := GetReplenishTx(module, txHash, chainID)
+			if err != nil {
+				return fmt.Errorf("ExpireReplenishTxs, %v", err)
+			}
+			if ok && last == request.Height {
+				module.GetCacheDB().Delete(replenishTxKey(chainID, txHash))
+			}
+		}
+		module.GetCacheDB().Delete(replenishRequestKey(chainID, head))
+	}
+	if head == queue.Head {
+		return nil
+	}
+	queue.Head = head
+	return putReplenishQueue(module, chainID, queue)
+}
+
+func replenishQueueKey(chainID uint64) []byte {
+	return utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(REPLENISH_QUEUE), utils.GetUint64Bytes(chainID))
+}
+
+func replenishRequestKey(chainID, index uint64) []byte {
+	return utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(REPLENISH_REQUEST), utils.GetUint64Bytes(chainID),
+		utils.GetUint64Bytes(index))
+}
+
+func getReplenishQueue(module *contract.ModuleContract, chainID uint64) (*ReplenishQueue, error) {
+	store, err := module.GetCacheDB().Get(replenishQueueKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getReplenishQueue, get queue store error: %v", err)
+	}
+	queue := new(ReplenishQueue)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, queue); err != nil {
+			return nil, fmt.Errorf("getReplenishQueue, deserialize queue error: %v", err)
+		}
+	}
+	return queue, nil
+}
+
+func putReplenishQueue(module *contract.ModuleContract, chainID uint64, queue *ReplenishQueue) error {
+	blob, err := rlp.EncodeToBytes(queue)
+	if err != nil {
+		return fmt.Errorf("putReplenishQueue, rlp.EncodeToBytes queue error: %v", err)
+	}
+	return module.GetCacheDB().Put(replenishQueueKey(chainID), blob)
+}
+
+// replenishTxKey is the same for the hex forms of a tx hash that only differ in case or the 0x prefix
+func replenishTxKey(chainID uint64, txHash string) []byte {
+	contractAddr := cfg.CrossChainManagerContractAddress
+	return utils.ConcatKey(contractAddr, []byte(REPLENISH_TX), utils.GetUint64Bytes(chainID), []byte(Replace0x(txHash)))
+}
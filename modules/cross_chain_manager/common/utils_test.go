@@ -19,11 +19,14 @@
 package common
 
 import (
+	"math"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,3 +60,81 @@ func TestUint256ToBytes(t *testing.T) {
 		assert.Equal(t, v.Expect, gotNum)
 	}
 }
+
+func TestRippleTxArgs(t *testing.T) {
+	args := &RippleTxArgs{
+		ToAddress: []byte{1, 2, 3},
+		Amount:    big.NewInt(1000000),
+	}
+	blob, err := EncodeRippleTxArgs(args)
+	assert.Nil(t, err)
+	got, err := DecodeRippleTxArgs(blob)
+	assert.Nil(t, err)
+	assert.Equal(t, args.ToAddress, got.ToAddress)
+	assert.Equal(t, args.Amount, got.Amount)
+	assert.Nil(t, got.DestinationTag)
+	assert.Equal(t, 0, len(got.Memos))
+
+	tag := uint32(42)
+	args.DestinationTag = &tag
+	args.Memos = []RippleMemo{{MemoType: []byte("type"), MemoData: []byte("data"), MemoFormat: []byte{}}}
+	blob, err = EncodeRippleTxArgs(args)
+	assert.Nil(t, err)
+	got, err = DecodeRippleTxArgs(blob)
+	assert.Nil(t, err)
+	assert.Equal(t, args.ToAddress, got.ToAddress)
+	assert.Equal(t, args.Amount, got.Amount)
+	assert.Equal(t, tag, *got.DestinationTag)
+	assert.Equal(t, args.Memos, got.Memos)
+	assert.Equal(t, 0, len(got.Sender))
+
+	// a sender alone is carried in the extended layout
+	args = &RippleTxArgs{ToAddress: []byte{1, 2, 3}, Amount: big.NewInt(1000000), Sender: []byte{0xaa}}
+	blob, err = EncodeRippleTxArgs(args)
+	assert.Nil(t, err)
+	got, err = DecodeRippleTxArgs(blob)
+	assert.Nil(t, err)
+	assert.Nil(t, got.DestinationTag)
+	assert.Equal(t, args.Sender, got.Sender)
+
+	// a missing destination tag stays missing in rlp when memos follow it
+	args = &RippleTxArgs{ToAddress: []byte{1, 2, 3}, Amount: big.NewInt(1000000),
+		Memos: []RippleMemo{{MemoType: []byte("type"), MemoData: []byte("data"), MemoFormat: []byte{}}}}
+	blob, err = rlp.EncodeToBytes(args)
+	assert.Nil(t, err)
+	got = new(RippleTxArgs)
+	assert.Nil(t, rlp.DecodeBytes(blob, got))
+	assert.Nil(t, got.DestinationTag)
+	assert.Equal(t, args.Memos, got.Memos)
+	args.DestinationTag = &tag
+	blob, err = rlp.EncodeToBytes(args)
+	assert.Nil(t, err)
+	got = new(RippleTxArgs)
+	assert.Nil(t, rlp.DecodeBytes(blob, got))
+	assert.Equal(t, tag, *got.DestinationTag)
+	assert.Equal(t, args.Memos, got.Memos)
+}
+
+func TestEntranceParamHeights(t *testing.T) {
+	for _, height := range []uint64{100, math.MaxUint32, math.MaxUint32 + 1} {
+		param := &EntranceParam{SourceChainID: 1, Height: height, Proof: []byte{1}, Extra: []byte{2}, Signature: []byte{3}}
+		payload, err := param.Encode()
+		assert.Nil(t, err)
+		method := MethodImportOuterTransfer
+		if height > math.MaxUint32 {
+			method = MethodImportOuterTransfer64
+		}
+		assert.Equal(t, ABI.Methods[method].ID, payload[:4])
+		decoded, err := UnpackEntranceParam(payload)
+		assert.Nil(t, err)
+		assert.Equal(t, param, decoded)
+	}
+
+	// digests of heights that fit in uint32 are the same as before heights were widened
+	param := &EntranceParam{SourceChainID: 1, Height: 100, Proof: []byte{1}, Extra: []byte{2}}
+	digest, err := param.Digest()
+	assert.Nil(t, err)
+	msg, err := rlp.EncodeToBytes([]interface{}{uint64(1), uint32(100), []byte{1}, []byte{2}, []byte(nil)})
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.Keccak256(msg), digest)
+}
@@ -19,8 +19,10 @@
 package cross_chain_manager
 
 import (
+	"encoding/hex"
 	"fmt"
 
+	common2 "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/contract/utils"
 	"github.com/polynetwork/zion-example/modules/cfg"
@@ -29,11 +31,19 @@ import (
 	"github.com/polynetwork/zion-example/modules/cross_chain_manager/no_proof"
 	"github.com/polynetwork/zion-example/modules/cross_chain_manager/ripple"
 	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/polynetwork/zion-example/modules/relayer_manager"
 	"github.com/polynetwork/zion-example/modules/side_chain_manager"
 )
 
 const (
 	BLACKED_CHAIN = "BlackedChain"
+
+	// tx hashes a replenish call can request
+	MAX_REPLENISH_HASHES = 200
+	// blocks in which a tx hash is not requested again
+	REPLENISH_WINDOW = 100
+	// queued replenish requests a call expires, more than the one it queues so the queue drains
+	MAX_EXPIRED_REPLENISH_REQUESTS = 2
 )
 
 // the real gas usage of `importOutTransfer` and `replenish` are 3291750 and 727125.
@@ -44,6 +54,13 @@ var (
 
 func InitCrossChainManager() {
 	contract.Contracts.RegisterContract(this, RegisterCrossChainManagerContract)
+
+	side_chain_manager.RegisterExtraInfoSchema(common.ETH_COMMON_ROUTER, side_chain_manager.ValidateEthExtraInfo)
+	side_chain_manager.RegisterExtraInfoSchema(common.RIPPLE_ROUTER, side_chain_manager.ValidateRippleExtraInfo)
+
+	side_chain_manager.RegisterChainCleaner("cross_chain_manager.black_chain", cleanBlackChain)
+	side_chain_manager.RegisterChainCleaner("cross_chain_manager.ripple", ripple.CleanRippleChain)
+	side_chain_manager.RegisterDrainCheck("cross_chain_manager.ripple", ripple.IsRippleChainDrained)
 }
 
 func RegisterCrossChainManagerContract(s *contract.ModuleContract) {
@@ -51,6 +68,7 @@ func RegisterCrossChainManagerContract(s *contract.ModuleContract) {
 
 	s.Register(common.MethodContractName, Name)
 	s.Register(common.MethodImportOuterTransfer, ImportOuterTransfer)
+	s.Register(common.MethodImportOuterTransfer64, ImportOuterTransfer)
 	s.Register(common.MethodBlackChain, BlackChain)
 	s.Register(common.MethodWhiteChain, WhiteChain)
 	s.Register(common.MethodCheckDone, CheckDone)
@@ -59,6 +77,12 @@ func RegisterCrossChainManagerContract(s *contract.ModuleContract) {
 	// ripple
 	s.Register(common.MethodMultiSignRipple, MultiSignRipple)
 	s.Register(common.MethodReconstructRippleTx, ReconstructRippleTx)
+	s.Register(common.MethodFlushRippleBatch, FlushRippleBatch)
+	s.Register(common.MethodMultiSignBatch, MultiSignRippleBatch)
+	s.Register(common.MethodConfirmRippleTx, ConfirmRippleTx)
+	s.Register(common.MethodGetRippleTxStatus, GetRippleTxStatus)
+	s.Register(common.MethodGetRippleVault, GetRippleVault)
+	s.Register(common.MethodReconcileRippleVault, ReconcileRippleVault)
 }
 
 func GetChainHandler(router uint64) (common.ChainHandler, error) {
@@ -96,10 +120,14 @@ func CheckDone(s *contract.ModuleContract) ([]byte, error) {
 
 func ImportOuterTransfer(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
-	params := &common.EntranceParam{}
-	if err := contract.UnpackMethod(common.ABI, common.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := common.UnpackEntranceParam(ctx.Payload)
+	if err != nil {
 		return nil, err
 	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, %v", err)
+	}
 
 	srcChainID := params.SourceChainID
 	blacked, err := CheckIfChainBlacked(s, srcChainID)
@@ -116,6 +144,9 @@ func ImportOuterTransfer(s *contract.ModuleContract) ([]byte, error) {
 	} else if srcChain == nil {
 		return nil, fmt.Errorf("ImportExTransfer, side chain %d is not registered", srcChainID)
 	}
+	if err := side_chain_manager.CheckSourceStatus(s, srcChainID); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, %v", err)
+	}
 
 	handler, err := GetChainHandler(srcChain.Router)
 	if err != nil {
@@ -150,12 +181,18 @@ func ImportOuterTransfer(s *contract.ModuleContract) ([]byte, error) {
 	if dstChain == nil {
 		return nil, fmt.Errorf("ImportExTransfer, side chain %d is not registered", dstChainID)
 	}
+	if err := side_chain_manager.CheckTargetStatus(s, dstChainID); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, %v", err)
+	}
 
 	if dstChain.Router == common.RIPPLE_ROUTER {
 		err := ripple.NewRippleHandler().MakeTransaction(s, txParam, srcChainID)
 		if err != nil {
 			return nil, err
 		}
+		if err := relayer_manager.RecordSubmission(s, relayer, true); err != nil {
+			return nil, fmt.Errorf("ImportExTransfer, %v", err)
+		}
 		return contract.PackOutputs(common.ABI, common.MethodImportOuterTransfer, true)
 	}
 
@@ -163,18 +200,28 @@ func ImportOuterTransfer(s *contract.ModuleContract) ([]byte, error) {
 	if err := common.MakeTransaction(s, txParam, srcChainID); err != nil {
 		return nil, err
 	}
+	if err := relayer_manager.RecordSubmission(s, relayer, true); err != nil {
+		return nil, fmt.Errorf("ImportExTransfer, %v", err)
+	}
 
 	return contract.PackOutputs(common.ABI, common.MethodImportOuterTransfer, true)
 }
 
 func MultiSignRipple(s *contract.ModuleContract) ([]byte, error) {
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("MultiSignRipple, %v", err)
+	}
 	handler := ripple.NewRippleHandler()
 
 	//1. multi sign
-	err := handler.MultiSign(s)
+	progress, err := handler.MultiSign(s)
 	if err != nil {
 		return nil, err
 	}
+	if err := relayer_manager.RecordSubmission(s, relayer, progress); err != nil {
+		return nil, fmt.Errorf("MultiSignRipple, %v", err)
+	}
 	return contract.PackOutputs(common.ABI, common.MethodMultiSignRipple, true)
 }
 
@@ -188,6 +235,84 @@ func ReconstructRippleTx(s *contract.ModuleContract) ([]byte, error) {
 	return contract.PackOutputs(common.ABI, common.MethodReconstructRippleTx, true)
 }
 
+func FlushRippleBatch(s *contract.ModuleContract) ([]byte, error) {
+	handler := ripple.NewRippleHandler()
+
+	err := handler.FlushBatch(s)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(common.ABI, common.MethodFlushRippleBatch, true)
+}
+
+func MultiSignRippleBatch(s *contract.ModuleContract) ([]byte, error) {
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("MultiSignRippleBatch, %v", err)
+	}
+	handler := ripple.NewRippleHandler()
+
+	progress, err := handler.MultiSignBatch(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := relayer_manager.RecordSubmission(s, relayer, progress); err != nil {
+		return nil, fmt.Errorf("MultiSignRippleBatch, %v", err)
+	}
+	return contract.PackOutputs(common.ABI, common.MethodMultiSignBatch, true)
+}
+
+func ConfirmRippleTx(s *contract.ModuleContract) ([]byte, error) {
+	handler := ripple.NewRippleHandler()
+
+	err := handler.ConfirmTx(s)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(common.ABI, common.MethodConfirmRippleTx, true)
+}
+
+func GetRippleTxStatus(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &common.RippleTxStatusParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodGetRippleTxStatus, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	status, err := ripple.GetTxStatus(s, params.FromChainId, params.ToChainId, params.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(common.ABI, common.MethodGetRippleTxStatus, status)
+}
+
+func GetRippleVault(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &common.RippleVaultParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodGetRippleVault, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(s, params.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleVault, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	vault, err := ripple.GetRippleVault(s, params.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(common.ABI, common.MethodGetRippleVault, vault.Balance(), vault.Pending,
+		vault.Deposited, vault.Withdrawn, vault.Fees, vault.IsSolvent(rippleExtraInfo.VaultReserve))
+}
+
+func ReconcileRippleVault(s *contract.ModuleContract) ([]byte, error) {
+	handler := ripple.NewRippleHandler()
+
+	err := handler.ReconcileVault(s)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(common.ABI, common.MethodReconcileRippleVault, true)
+}
+
 func BlackChain(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &common.BlackChainParam{}
@@ -237,13 +362,56 @@ func Replenish(s *contract.ModuleContract) ([]byte, error) {
 	if err := contract.UnpackMethod(common.ABI, common.MethodReplenish, params, ctx.Payload); err != nil {
 		return nil, fmt.Errorf("Replenish, unpack params error: %s", err)
 	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
+	}
 
-	if len(params.TxHashes) == 0 || len(params.TxHashes) > 200 {
-		return nil, fmt.Errorf("invalid replenish hash length, min 1, max 200, current %v", len(params.TxHashes))
+	if len(params.TxHashes) == 0 || len(params.TxHashes) > MAX_REPLENISH_HASHES {
+		return nil, fmt.Errorf("invalid replenish hash length, min 1, max %d, current %v", MAX_REPLENISH_HASHES, len(params.TxHashes))
 	}
-	err := common.NotifyReplenish(s, params.TxHashes, params.ChainID)
-	if err != nil {
-		return nil, fmt.Errorf("Replenish, NotifyReplenish error: %s", err)
+	for _, txHash := range params.TxHashes {
+		if raw, err := hex.DecodeString(common.Replace0x(txHash)); err != nil || len(raw) != common2.HashLength {
+			return nil, fmt.Errorf("Replenish, invalid tx hash %s", txHash)
+		}
+	}
+
+	current := s.ContractRef().BlockHeight().Uint64()
+	if err := common.ExpireReplenishTxs(s, params.ChainID, current, REPLENISH_WINDOW, MAX_EXPIRED_REPLENISH_REQUESTS); err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
+	}
+
+	// tx hashes repeated in the call or requested in the last REPLENISH_WINDOW blocks are dropped
+	txHashes := make([]string, 0, len(params.TxHashes))
+	seen := make(map[string]bool, len(params.TxHashes))
+	for _, txHash := range params.TxHashes {
+		if seen[common.Replace0x(txHash)] {
+			continue
+		}
+		seen[common.Replace0x(txHash)] = true
+		last, ok, err := common.GetReplenishTx(s, txHash, params.ChainID)
+		if err != nil {
+			return nil, fmt.Errorf("Replenish, %v", err)
+		}
+		if ok && current < last+REPLENISH_WINDOW {
+			continue
+		}
+		if err := common.PutReplenishTx(s, txHash, params.ChainID, current); err != nil {
+			return nil, fmt.Errorf("Replenish, PutReplenishTx error: %v", err)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	if len(txHashes) > 0 {
+		if err := common.PushReplenishRequest(s, params.ChainID, &common.ReplenishRequest{Height: current, TxHashes: txHashes}); err != nil {
+			return nil, fmt.Errorf("Replenish, %v", err)
+		}
+		if err := common.NotifyReplenish(s, txHashes, params.ChainID); err != nil {
+			return nil, fmt.Errorf("Replenish, NotifyReplenish error: %s", err)
+		}
+	}
+	// whether a tx hash exists on the source chain can not be checked here, so a request earns no reward
+	if err := relayer_manager.RecordSubmission(s, relayer, false); err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
 	}
 	return contract.PackOutputs(common.ABI, common.MethodReplenish, true)
 }
@@ -197,7 +197,7 @@ func TestImportOuterTransfer(t *testing.T) {
 			param.Signature, err = crypto.Sign(digest, keys[i])
 			assert.Nil(t, err)
 
-			input, err := contract.PackMethodWithStruct(scom.ABI, cross_chain_manager_abi.MethodImportOuterTransfer, param)
+			input, err := param.Encode()
 			assert.Nil(t, err)
 
 			blockNumber := big.NewInt(1)
@@ -215,7 +215,7 @@ func TestImportOuterTransfer(t *testing.T) {
 	}
 
 	for _, param := range []*scom.EntranceParam{param2, param3} {
-		input, err := contract.PackMethodWithStruct(scom.ABI, cross_chain_manager_abi.MethodImportOuterTransfer, param)
+		input, err := param.Encode()
 		assert.Nil(t, err)
 
 		blockNumber := big.NewInt(1)
@@ -265,6 +265,51 @@ func TestReplenish(t *testing.T) {
 	tr.Dump()
 }
 
+func TestReplenishDedup(t *testing.T) {
+	chainID := uint64(10)
+	call := func(height int64, txHashes ...string) error {
+		input, err := contract.PackMethodWithStruct(scom.ABI, cross_chain_manager_abi.MethodReplenish, &scom.ReplenishParam{ChainID: chainID, TxHashes: txHashes})
+		assert.Nil(t, err)
+		caller := common.Address{}
+		contractRef := contract.NewContractRef(sdb, caller, caller, big.NewInt(height), common.Hash{}, 2100000000, nil)
+		_, _, err = contractRef.ModuleCall(caller, cfg.CrossChainManagerContractAddress, input)
+		return err
+	}
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil))
+	record := func(txHash string) (uint64, bool) {
+		last, ok, err := scom.GetReplenishTx(c, txHash, chainID)
+		assert.Nil(t, err)
+		return last, ok
+	}
+	txHash := "0x" + strings.Repeat("ab", 32)
+
+	// tx hashes have to be 32 bytes of hex
+	assert.NotNil(t, call(1, "0x1234"))
+	assert.NotNil(t, call(1, "0x"+strings.Repeat("zz", 32)))
+	assert.NotNil(t, call(1, txHash, txHash+"ab"))
+
+	// the hex forms of a tx hash are the same request, it is requested again once the window passed
+	assert.Nil(t, call(1, txHash, strings.ToUpper(txHash[2:])))
+	last, ok := record(txHash)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), last)
+	assert.Nil(t, call(REPLENISH_WINDOW, txHash))
+	last, _ = record(strings.ToUpper(txHash[2:]))
+	assert.Equal(t, uint64(1), last)
+	assert.Nil(t, call(REPLENISH_WINDOW+1, txHash))
+	last, _ = record(txHash)
+	assert.Equal(t, uint64(REPLENISH_WINDOW+1), last)
+
+	// records expire with the requests of later calls
+	other := "0x" + strings.Repeat("cd", 32)
+	assert.Nil(t, call(2*REPLENISH_WINDOW+1, other))
+	_, ok = record(txHash)
+	assert.False(t, ok)
+	last, ok = record(other)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2*REPLENISH_WINDOW+1), last)
+}
+
 func TestCheckDone(t *testing.T) {
 	param := new(scom.CheckDoneParam)
 	param.CrossChainID = make([]byte, 32)
@@ -43,8 +43,8 @@ func NewHandler() *Handler {
 
 func (h *Handler) MakeDepositProposal(service *contract.ModuleContract) (txParam *common2.MakeTxParam, err error) {
 	ctx := service.ContractRef().CurrentContext()
-	params := &common2.EntranceParam{}
-	if err := contract.UnpackMethod(common2.ABI, common2.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := common2.UnpackEntranceParam(ctx.Payload)
+	if err != nil {
 		return nil, err
 	}
 
@@ -85,23 +85,31 @@ func (h *Handler) VerifyDepositProposal(service *contract.ModuleContract,
 		return
 	}
 
-	info, err := info_sync.GetRootInfo(service, sideChain.ChainID, params.Height)
+	header, err := info_sync.GetHeaderFieldsObj(service, sideChain.ChainID, params.Height)
 	if err != nil {
-		err = fmt.Errorf("get root info failure, err %v", err)
+		err = fmt.Errorf("get header fields failure, err %v", err)
 		return
 	}
-	if info == nil {
+	if header == nil {
 		err = fmt.Errorf("root info missing for height %d", params.Height)
 		return
 	}
+	if err = checkConfirmations(service, sideChain.ChainID, params.Height); err != nil {
+		return
+	}
 
-	header, err := DecodeHeader(info)
+	// proofs are verified against the config that was active at the proven height
+	config, err := side_chain_manager.GetSideChainObjectAtHeight(service, sideChain.ChainID, uint64(params.Height))
 	if err != nil {
-		err = fmt.Errorf("decode root ifno failure, %v", err)
+		err = fmt.Errorf("get side chain config at height %d failure, err: %v", params.Height, err)
+		return
+	}
+	if config == nil {
+		err = fmt.Errorf("side chain config missing for height %d", params.Height)
 		return
 	}
 
-	err = VerifyCrossChainProof(crypto.Keccak256(params.Extra), proof, header.Root, sideChain.CCMCAddress)
+	err = VerifyCrossChainProof(crypto.Keccak256(params.Extra), proof, header.StateRoot, config.CCMCAddress)
 	if err != nil {
 		err = fmt.Errorf("VerifyCrossChainProof failed, err: %v", err)
 		return
@@ -111,6 +119,26 @@ func (h *Handler) VerifyDepositProposal(service *contract.ModuleContract,
 	return
 }
 
+// checkConfirmations refuses proofs against headers with less synced headers on top than the side chain requires
+func checkConfirmations(service *contract.ModuleContract, chainID uint64, height uint64) error {
+	extraInfo, err := side_chain_manager.GetEthExtraInfo(service, chainID)
+	if err != nil {
+		return fmt.Errorf("get eth extra info failure, err: %v", err)
+	}
+	if extraInfo.Confirmations == 0 {
+		return nil
+	}
+	current, err := info_sync.GetCurrentHeight(service, chainID)
+	if err != nil {
+		return fmt.Errorf("get current synced height failure, err: %v", err)
+	}
+	if height+extraInfo.Confirmations > current {
+		return fmt.Errorf("height %d is not confirmed, current synced height %d, confirmations %d", height,
+			current, extraInfo.Confirmations)
+	}
+	return nil
+}
+
 // Proof ...
 type Proof struct {
 	Address       string         `json:"address"`
@@ -230,17 +258,3 @@ func CheckProofResult(result, value []byte) (err error) {
 	}
 	return
 }
-
-type Header struct {
-	Root common.Hash `json:"stateRoot" gencodec:"required"`
-}
-
-// Decode header
-func DecodeHeader(data []byte) (h *Header, err error) {
-	h = new(Header)
-	err = json.Unmarshal(data, h)
-	if err != nil {
-		h = nil
-	}
-	return
-}
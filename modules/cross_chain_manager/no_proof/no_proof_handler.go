@@ -36,8 +36,8 @@ func NewNoProofHandler() *NoProofHandler {
 
 func (this *NoProofHandler) MakeDepositProposal(service *contract.ModuleContract) (*common.MakeTxParam, error) {
 	ctx := service.ContractRef().CurrentContext()
-	params := &common.EntranceParam{}
-	if err := contract.UnpackMethod(common.ABI, common.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := common.UnpackEntranceParam(ctx.Payload)
+	if err != nil {
 		return nil, err
 	}
 
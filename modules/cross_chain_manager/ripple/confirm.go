@@ -0,0 +1,343 @@
+/*
+ * Copyright (C) 2021 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ripple
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polynetwork/ripple-sdk/types"
+	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/polynetwork/zion-example/modules/go_abi/cross_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+	"github.com/rubblelabs/ripple/data"
+)
+
+// classifyResult decides how a final ledger result is handled. tec results claimed the fee and
+// tem results can never apply, so the transfers are refunded. Any other failure did not touch
+// the multisign account and the payment is issued again with a fresh sequence.
+func classifyResult(result data.TransactionResult) uint8 {
+	switch {
+	case result.Success():
+		return RESULT_SETTLE
+	case result >= 100:
+		return RESULT_REFUND
+	case result >= -299 && result <= -200:
+		return RESULT_REFUND
+	default:
+		return RESULT_RECONSTRUCT
+	}
+}
+
+// paymentDrops is what the multisign account commits to a payment, amount plus fee
+func paymentDrops(payment *data.Payment) *big.Int {
+	committed := ToIntByPrecise(payment.Amount.Value.String(), 6)
+	return committed.Add(committed, ToIntByPrecise(payment.Fee.String(), 6))
+}
+
+// splitRefund shares the fee claimed by the ledger between the transfers of a payment
+func splitRefund(amounts []*big.Int, fee *big.Int) []*big.Int {
+	total := new(big.Int)
+	for _, a := range amounts {
+		total.Add(total, a)
+	}
+	refunds := make([]*big.Int, len(amounts))
+	charged := new(big.Int)
+	for i, a := range amounts {
+		share := new(big.Int)
+		if i == len(amounts)-1 {
+			share.Sub(fee, charged)
+		} else if total.Sign() > 0 {
+			share.Mul(fee, a).Div(share, total)
+		}
+		charged.Add(charged, share)
+		refunds[i] = new(big.Int).Sub(a, share)
+		if refunds[i].Sign() < 0 {
+			refunds[i].SetUint64(0)
+		}
+	}
+	return refunds
+}
+
+// rebuildPayment issues the same payment again with a new sequence and fee, the committed
+// drops are kept so the fee is still paid out of the transferred amount
+func rebuildPayment(payment *data.Payment, sequence uint32, fee *big.Int) (*data.Payment, error) {
+	amountDrops := new(big.Int).Sub(paymentDrops(payment), fee)
+	if amountDrops.Sign() <= 0 {
+		return nil, fmt.Errorf("fee %s exceeds payment", fee.String())
+	}
+	amount, err := data.NewAmount(amountDrops.String())
+	if err != nil {
+		return nil, fmt.Errorf("data.NewAmount error: %s", err)
+	}
+	feeValue, err := data.NewValue(ToStringByPrecise(fee, 6), true)
+	if err != nil {
+		return nil, fmt.Errorf("data.NewValue fee error: %s", err)
+	}
+	rebuilt := types.GeneratePayment(payment.Account, payment.Destination, *amount, *feeValue, sequence)
+	rebuilt.DestinationTag = payment.DestinationTag
+	rebuilt.Memos = payment.Memos
+	return rebuilt, nil
+}
+
+// ConfirmTx records the ledger result of a signed payment once a quorum of voters attested it.
+// Failed payments are issued again or refunded to their source chains.
+func (this *RippleHandler) ConfirmTx(service *contract.ModuleContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &common.ConfirmRippleTxParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodConfirmRippleTx, params, ctx.Payload); err != nil {
+		return fmt.Errorf("ConfirmTx, contract params deserialize error: %v", err)
+	}
+	if err := side_chain_manager.CheckSourceStatus(service, params.ToChainId); err != nil {
+		return fmt.Errorf("ConfirmTx, %v", err)
+	}
+
+	raw, err := GetTxJsonInfo(service, params.FromChainId, params.TxHash)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, GetTxJsonInfo error: %v", err)
+	}
+	multisignInfo, err := GetMultisignInfo(service, raw)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, GetMultisignInfo error: %v", err)
+	}
+	if !multisignInfo.Status {
+		return fmt.Errorf("ConfirmTx, payment is not signed yet")
+	}
+	exist, err := GetRippleTxResult(service, raw)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, GetRippleTxResult error: %v", err)
+	}
+	if exist != nil {
+		return fmt.Errorf("ConfirmTx, payment is already confirmed with %s", exist.Result)
+	}
+	var result data.TransactionResult
+	if err := result.UnmarshalText([]byte(params.Result)); err != nil {
+		return fmt.Errorf("ConfirmTx, invalid ledger result: %v", err)
+	}
+
+	// the raw tx is part of the input, a reissued payment may fail with the same result again
+	input := crypto.Keccak256(ctx.Payload, []byte(raw))
+	ok, err := node_manager.CheckConsensusSigns(service, common.MethodConfirmRippleTx, input,
+		service.ContractRef().MsgSender(), node_manager.Voter)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, node_manager.CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	payment, err := types.DeserializeRawMultiSignTx(raw)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, types.DeserializeRawMultiSignTx error: %v", err)
+	}
+	committed := paymentDrops(payment)
+	transfers, err := GetRipplePayment(service, raw)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, GetRipplePayment error: %v", err)
+	}
+	// payments signed before the vault existed never committed anything to it
+	recorded := transfers != nil
+	if !recorded {
+		transfers = &RipplePayment{
+			FromChainIDs: []uint64{params.FromChainId},
+			TxHashes:     [][]byte{params.TxHash},
+			Amounts:      []*big.Int{committed},
+		}
+	}
+
+	action := classifyResult(result)
+	delivered := new(big.Int).SetUint64(params.Delivered)
+	fee := new(big.Int).SetUint64(params.Fee)
+	switch action {
+	case RESULT_RECONSTRUCT:
+		delivered.SetUint64(0)
+		fee.SetUint64(0)
+	case RESULT_REFUND:
+		delivered.SetUint64(0)
+	}
+	if new(big.Int).Add(delivered, fee).Cmp(committed) > 0 {
+		return fmt.Errorf("ConfirmTx, delivered %s and fee %s exceed payment %s", delivered.String(),
+			fee.String(), committed.String())
+	}
+	if recorded {
+		if err := settlePayment(service, params.ToChainId, committed, delivered, fee); err != nil {
+			return fmt.Errorf("ConfirmTx, settlePayment error: %v", err)
+		}
+	}
+
+	if action == RESULT_RECONSTRUCT {
+		issued, err := reissuePayment(service, params.ToChainId, payment, committed, transfers)
+		if err != nil {
+			return fmt.Errorf("ConfirmTx, reissuePayment error: %v", err)
+		}
+		// the vault can not cover the payment any more, give the transfers back instead
+		if !issued {
+			action = RESULT_REFUND
+		}
+	}
+	if action == RESULT_REFUND {
+		if err := refundPayment(service, params.ToChainId, raw, transfers, fee); err != nil {
+			return fmt.Errorf("ConfirmTx, refundPayment error: %v", err)
+		}
+	}
+
+	err = PutRippleTxResult(service, raw, &RippleTxResult{
+		Result:    params.Result,
+		Delivered: delivered,
+		Fee:       fee,
+		Action:    action,
+	})
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, PutRippleTxResult error: %v", err)
+	}
+	err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventRippleTxResult}, params.FromChainId,
+		params.ToChainId, hex.EncodeToString(params.TxHash), params.Result)
+	if err != nil {
+		return fmt.Errorf("ConfirmTx, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+func reissuePayment(service *contract.ModuleContract, toChainId uint64, payment *data.Payment, committed *big.Int,
+	transfers *RipplePayment) (bool, error) {
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, toChainId)
+	if err != nil {
+		return false, fmt.Errorf("reissuePayment, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	vault, err := GetRippleVault(service, toChainId)
+	if err != nil {
+		return false, fmt.Errorf("reissuePayment, GetRippleVault error: %v", err)
+	}
+	if err := vault.commit(committed, rippleExtraInfo.VaultReserve); err != nil {
+		return false, nil
+	}
+	if err := PutRippleVault(service, toChainId, vault); err != nil {
+		return false, fmt.Errorf("reissuePayment, PutRippleVault error: %v", err)
+	}
+
+	baseFee, err := side_chain_manager.GetFeeObj(service, toChainId)
+	if err != nil {
+		return false, fmt.Errorf("reissuePayment, side_chain_manager.GetFee error: %v", err)
+	}
+	if baseFee.View == 0 {
+		return false, fmt.Errorf("reissuePayment, base fee is not initialized")
+	}
+	fee := new(big.Int).Mul(baseFee.Fee, new(big.Int).SetUint64(rippleExtraInfo.SignerNum))
+	rebuilt, err := rebuildPayment(payment, uint32(rippleExtraInfo.Sequence), fee)
+	if err != nil {
+		return false, fmt.Errorf("reissuePayment, rebuildPayment error: %v", err)
+	}
+	_, raw, err := data.Raw(rebuilt)
+	if err != nil {
+		return false, fmt.Errorf("reissuePayment, data.Raw error: %s", err)
+	}
+	rippleExtraInfo.Sequence = rippleExtraInfo.Sequence + 1
+	if err := side_chain_manager.PutRippleExtraInfo(service, toChainId, rippleExtraInfo); err != nil {
+		return false, fmt.Errorf("reissuePayment, side_chain_manager.PutRippleExtraInfo error: %s", err)
+	}
+	if err := putPayment(service, hex.EncodeToString(raw), transfers); err != nil {
+		return false, fmt.Errorf("reissuePayment, putPayment error: %s", err)
+	}
+	// every transfer paid out by the payment is notified with the reissued tx
+	for i, fromChainId := range transfers.FromChainIDs {
+		err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventRippleTx}, fromChainId, toChainId,
+			hex.EncodeToString(transfers.TxHashes[i]), hex.EncodeToString(raw), rebuilt.Sequence)
+		if err != nil {
+			return false, fmt.Errorf("reissuePayment, AddNotify error: %v", err)
+		}
+	}
+	return true, nil
+}
+
+// refundPayment makes a refund request to the source lock proxy of every transfer of the payment
+func refundPayment(service *contract.ModuleContract, toChainId uint64, raw string, transfers *RipplePayment, fee *big.Int) error {
+	params, err := refundTxParams(service, toChainId, raw, transfers, fee)
+	if err != nil {
+		return fmt.Errorf("refundPayment, %v", err)
+	}
+	for _, param := range params {
+		if err := common.MakeTransactionWithHash(service, param.CrossChainID, param, toChainId); err != nil {
+			return fmt.Errorf("refundPayment, common.MakeTransactionWithHash error: %v", err)
+		}
+	}
+	return nil
+}
+
+// refundTxParams makes the refund requests of the transfers of a payment, each is paid to the sender
+// carried in the args of the request the transfer was made for. Requests in the plain layout carry no
+// sender, their refunds have an empty address and the lock proxy pays the sender of the tx hash
+func refundTxParams(service *contract.ModuleContract, toChainId uint64, raw string, transfers *RipplePayment,
+	fee *big.Int) ([]*common.MakeTxParam, error) {
+	assetBind, err := side_chain_manager.GetAssetBind(service, toChainId)
+	if err != nil {
+		return nil, fmt.Errorf("refundTxParams, side_chain_manager.GetAssetBind error: %v", err)
+	}
+	vault, ok := assetBind.AssetMap[toChainId]
+	if !ok {
+		return nil, fmt.Errorf("refundTxParams, asset map of chain %d is not registered", toChainId)
+	}
+	params := make([]*common.MakeTxParam, 0, len(transfers.Amounts))
+	for i, amount := range splitRefund(transfers.Amounts, fee) {
+		if amount.Sign() == 0 {
+			continue
+		}
+		fromChainId := transfers.FromChainIDs[i]
+		lockProxy, ok := assetBind.LockProxyMap[fromChainId]
+		if !ok {
+			return nil, fmt.Errorf("refundTxParams, assetBind.LockProxyMap of %d not exist", fromChainId)
+		}
+		request, err := GetRippleTxParam(service, fromChainId, transfers.TxHashes[i])
+		if err != nil {
+			return nil, fmt.Errorf("refundTxParams, GetRippleTxParam error: %v", err)
+		}
+		if request == nil {
+			return nil, fmt.Errorf("refundTxParams, request of tx %x from chain %d is not recorded", transfers.TxHashes[i], fromChainId)
+		}
+		requestArgs, err := common.DecodeRippleTxArgs(request.Args)
+		if err != nil {
+			return nil, fmt.Errorf("refundTxParams, common.DecodeRippleTxArgs error: %v", err)
+		}
+		amount, err := side_chain_manager.ConvertAmount(service, toChainId, vault, fromChainId, amount, true)
+		if err != nil {
+			return nil, fmt.Errorf("refundTxParams, side_chain_manager.ConvertAmount error: %v", err)
+		}
+		args, err := common.EncodeRippleTxArgs(&common.RippleTxArgs{
+			ToAddress: requestArgs.Sender,
+			Amount:    amount,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("refundTxParams, common.EncodeRippleTxArgs error: %v", err)
+		}
+		crossChainID := crypto.Keccak256([]byte(raw), utils.GetUint64Bytes(uint64(i)))
+		params = append(params, &common.MakeTxParam{
+			TxHash:              transfers.TxHashes[i],
+			CrossChainID:        crossChainID,
+			FromContractAddress: vault,
+			ToChainID:           fromChainId,
+			ToContractAddress:   lockProxy,
+			Method:              REFUND_METHOD,
+			Args:                args,
+		})
+	}
+	return params, nil
+}
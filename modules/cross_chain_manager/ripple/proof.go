@@ -0,0 +1,133 @@
+/*
+ * Copyright (C) 2021 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ripple
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	rcrypto "github.com/rubblelabs/ripple/crypto"
+	"github.com/rubblelabs/ripple/data"
+)
+
+const (
+	// size of an inner node of the transaction SHAMap, 16 child hashes
+	INNER_NODE_SIZE = 16 * 32
+	// max depth of the transaction SHAMap, one level per nibble of the key
+	MAX_PROOF_DEPTH = 64
+)
+
+// RippleTxProof proves that a transaction and its metadata are included in the
+// transaction tree of a validated ledger. Path holds the inner nodes from the
+// root down to the parent of the leaf.
+type RippleTxProof struct {
+	Tx   []byte
+	Meta []byte
+	Path [][]byte
+}
+
+// RippleDepositMemo is carried as json in the memo data of an inbound payment
+type RippleDepositMemo struct {
+	ToChainID uint64 `json:"toChainId"`
+	ToAddress string `json:"toAddress"`
+}
+
+func sha512Half(prefix data.HashPrefix, blobs ...[]byte) data.Hash256 {
+	buf := new(bytes.Buffer)
+	_ = binary.Write(buf, binary.BigEndian, uint32(prefix))
+	for _, b := range blobs {
+		buf.Write(b)
+	}
+	var h data.Hash256
+	copy(h[:], rcrypto.Sha512Half(buf.Bytes()))
+	return h
+}
+
+// variableLength encodes the xrpl length prefix of a variable length field
+func variableLength(n int) ([]byte, error) {
+	switch {
+	case n < 0 || n > 918744:
+		return nil, fmt.Errorf("unsupported variable length: %d", n)
+	case n <= 192:
+		return []byte{byte(n)}, nil
+	case n <= 12480:
+		n -= 193
+		return []byte{byte(193 + (n >> 8)), byte(n)}, nil
+	default:
+		n -= 12481
+		return []byte{byte(241 + (n >> 16)), byte(n >> 8), byte(n)}, nil
+	}
+}
+
+func txLeafHash(txID data.Hash256, tx, meta []byte) (data.Hash256, error) {
+	txLen, err := variableLength(len(tx))
+	if err != nil {
+		return data.Hash256{}, err
+	}
+	metaLen, err := variableLength(len(meta))
+	if err != nil {
+		return data.Hash256{}, err
+	}
+	return sha512Half(data.HP_TRANSACTION_NODE, txLen, tx, metaLen, meta, txID[:]), nil
+}
+
+func nibble(key data.Hash256, depth int) byte {
+	b := key[depth/2]
+	if depth%2 == 0 {
+		return b >> 4
+	}
+	return b & 0x0f
+}
+
+// VerifyTxProof checks the proof against the transaction hash of a ledger and
+// returns the transaction id
+func VerifyTxProof(proof *RippleTxProof, root data.Hash256) (data.Hash256, error) {
+	if len(proof.Path) == 0 || len(proof.Path) > MAX_PROOF_DEPTH {
+		return data.Hash256{}, fmt.Errorf("VerifyTxProof, invalid proof depth: %d", len(proof.Path))
+	}
+	txID := sha512Half(data.HP_TRANSACTION_ID, proof.Tx)
+	node, err := txLeafHash(txID, proof.Tx, proof.Meta)
+	if err != nil {
+		return data.Hash256{}, fmt.Errorf("VerifyTxProof, txLeafHash error: %v", err)
+	}
+	for depth := len(proof.Path) - 1; depth >= 0; depth-- {
+		inner := proof.Path[depth]
+		if len(inner) != INNER_NODE_SIZE {
+			return data.Hash256{}, fmt.Errorf("VerifyTxProof, invalid inner node size at depth %d", depth)
+		}
+		pos := int(nibble(txID, depth))
+		if !bytes.Equal(inner[pos*32:(pos+1)*32], node[:]) {
+			return data.Hash256{}, fmt.Errorf("VerifyTxProof, child hash mismatch at depth %d", depth)
+		}
+		node = sha512Half(data.HP_INNER_NODE, inner)
+	}
+	if node != root {
+		return data.Hash256{}, fmt.Errorf("VerifyTxProof, root mismatch, expect %s, got %s", root.String(), node.String())
+	}
+	return txID, nil
+}
+
+// DecodeLedgerHeader parses the binary xrpl ledger header synced through info_sync
+func DecodeLedgerHeader(raw []byte) (*data.LedgerHeader, error) {
+	ledger, err := data.ReadLedger(bytes.NewReader(raw), data.Hash256{})
+	if err != nil {
+		return nil, fmt.Errorf("DecodeLedgerHeader, data.ReadLedger error: %v", err)
+	}
+	return &ledger.LedgerHeader, nil
+}
@@ -18,10 +18,12 @@
 package ripple
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -29,6 +31,7 @@ import (
 	"github.com/polynetwork/ripple-sdk/types"
 	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
 	"github.com/polynetwork/zion-example/modules/go_abi/cross_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/info_sync"
 	"github.com/polynetwork/zion-example/modules/node_manager"
 	"github.com/polynetwork/zion-example/modules/side_chain_manager"
 	"github.com/rubblelabs/ripple/data"
@@ -43,11 +46,19 @@ func NewRippleHandler() *RippleHandler {
 
 func (this *RippleHandler) MakeDepositProposal(service *contract.ModuleContract) (*common.MakeTxParam, error) {
 	ctx := service.ContractRef().CurrentContext()
-	params := &common.EntranceParam{}
-	if err := contract.UnpackMethod(common.ABI, common.MethodImportOuterTransfer, params, ctx.Payload); err != nil {
+	params, err := common.UnpackEntranceParam(ctx.Payload)
+	if err != nil {
 		return nil, err
 	}
 
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ripple MakeDepositProposal, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	if rippleExtraInfo.ProofMode {
+		return this.makeDepositProposalByProof(service, params)
+	}
+
 	//verify signature
 	digest, err := params.Digest()
 	if err != nil {
@@ -92,7 +103,18 @@ func (this *RippleHandler) MakeDepositProposal(service *contract.ModuleContract)
 		if err != nil {
 			return nil, fmt.Errorf("ripple MakeDepositProposal, rlp.DecodeBytes error: %s", err)
 		}
+		if err := recordDeposit(service, params.SourceChainID, args.Amount); err != nil {
+			return nil, fmt.Errorf("ripple MakeDepositProposal, recordDeposit error: %s", err)
+		}
+		args.Amount, err = side_chain_manager.ConvertAmount(service, params.SourceChainID,
+			assetBind.AssetMap[params.SourceChainID], txParam.ToChainID, args.Amount, true)
+		if err != nil {
+			return nil, fmt.Errorf("ripple MakeDepositProposal, side_chain_manager.ConvertAmount error: %s", err)
+		}
 		b, err := common.EncodeRippleTxArgs(args)
+		if err != nil {
+			return nil, fmt.Errorf("ripple MakeDepositProposal, common.EncodeRippleTxArgs error: %s", err)
+		}
 		txParam.Args = b
 
 		return txParam, nil
@@ -100,52 +122,218 @@ func (this *RippleHandler) MakeDepositProposal(service *contract.ModuleContract)
 	return nil, nil
 }
 
-func (this *RippleHandler) MultiSign(service *contract.ModuleContract) error {
+// makeDepositProposalByProof verifies the deposit payment against a ledger header synced
+// by voters through info_sync, instead of trusting a voter attestation of the deposit
+func (this *RippleHandler) makeDepositProposalByProof(service *contract.ModuleContract, params *common.EntranceParam) (*common.MakeTxParam, error) {
+	proof := new(RippleTxProof)
+	if err := rlp.DecodeBytes(params.Proof, proof); err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, deserialize proof error: %v", err)
+	}
+
+	raw, err := info_sync.GetRootInfo(service, params.SourceChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, info_sync.GetRootInfo error: %v", err)
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, ledger %d is not synced", params.Height)
+	}
+	header, err := DecodeLedgerHeader(raw)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, DecodeLedgerHeader error: %v", err)
+	}
+	if uint64(header.LedgerSequence) != params.Height {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, ledger sequence %d mismatch height %d",
+			header.LedgerSequence, params.Height)
+	}
+	txID, err := VerifyTxProof(proof, header.TransactionHash)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, VerifyTxProof error: %v", err)
+	}
+
+	txm, err := data.ReadTransactionAndMetadata(bytes.NewReader(proof.Tx), bytes.NewReader(proof.Meta), txID, header.LedgerSequence)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, data.ReadTransactionAndMetadata error: %v", err)
+	}
+	if !txm.MetaData.TransactionResult.Success() {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, transaction failed: %s", txm.MetaData.TransactionResult.String())
+	}
+	payment, ok := txm.Transaction.(*data.Payment)
+	if !ok {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, transaction is not a payment")
+	}
+
+	assetBind, err := side_chain_manager.GetAssetBind(service, params.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, side_chain_manager.GetAssetBind error: %v", err)
+	}
+	vault, ok := assetBind.AssetMap[params.SourceChainID]
+	if !ok {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, asset map of chain %d is not registered", params.SourceChainID)
+	}
+	if !bytes.Equal(payment.Destination[:], vault) {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, payment destination is not the multisign account")
+	}
+	delivered := payment.Amount
+	if txm.MetaData.DeliveredAmount != nil {
+		delivered = *txm.MetaData.DeliveredAmount
+	}
+	if !delivered.IsNative() {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, delivered amount is not xrp")
+	}
+
+	memo, err := decodeDepositMemo(payment.Memos)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, decodeDepositMemo error: %v", err)
+	}
+	toAddress, err := hex.DecodeString(strings.TrimPrefix(memo.ToAddress, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, decode to address error: %v", err)
+	}
+
+	if err := common.CheckDoneTx(service, txID[:], params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, check done transaction error:%s", err)
+	}
+	if err := common.PutDoneTx(service, txID[:], params.SourceChainID); err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, PutDoneTx error:%s", err)
+	}
+
+	lockProxy, ok := assetBind.LockProxyMap[memo.ToChainID]
+	if !ok {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, assetBind.LockProxyMap of %d not exist", memo.ToChainID)
+	}
+	drops := ToIntByPrecise(delivered.Value.String(), 6)
+	if err := recordDeposit(service, params.SourceChainID, drops); err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, recordDeposit error: %v", err)
+	}
+	amount, err := side_chain_manager.ConvertAmount(service, params.SourceChainID, vault, memo.ToChainID, drops, true)
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, side_chain_manager.ConvertAmount error: %v", err)
+	}
+	args, err := common.EncodeRippleTxArgs(&common.RippleTxArgs{
+		ToAddress: toAddress,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ripple makeDepositProposalByProof, common.EncodeRippleTxArgs error: %v", err)
+	}
+	return &common.MakeTxParam{
+		TxHash:              txID[:],
+		CrossChainID:        txID[:],
+		FromContractAddress: vault,
+		ToChainID:           memo.ToChainID,
+		ToContractAddress:   lockProxy,
+		Method:              UNLOCK_METHOD,
+		Args:                args,
+	}, nil
+}
+
+// MultiSign collects the signatures of a payment, it reports whether a new signature was accepted
+func (this *RippleHandler) MultiSign(service *contract.ModuleContract) (bool, error) {
 	ctx := service.ContractRef().CurrentContext()
 	params := &common.MultiSignParam{}
 	if err := contract.UnpackMethod(common.ABI, common.MethodMultiSignRipple, params, ctx.Payload); err != nil {
-		return fmt.Errorf("MultiSign, contract params deserialize error: %v", err)
+		return false, fmt.Errorf("MultiSign, contract params deserialize error: %v", err)
+	}
+	// payments accepted before the chain stopped taking transfers are still settled while it drains
+	if err := side_chain_manager.CheckSourceStatus(service, params.ToChainId); err != nil {
+		return false, fmt.Errorf("MultiSign, %v", err)
 	}
 
 	// get rippleExtraInfo
 	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.ToChainId)
 	if err != nil {
-		return fmt.Errorf("MultiSign, side_chain_manager.GetRippleExtraInfo error: %v", err)
+		return false, fmt.Errorf("MultiSign, side_chain_manager.GetRippleExtraInfo error: %v", err)
 	}
 
 	// get raw txJsonInfo
 	raw, err := GetTxJsonInfo(service, params.FromChainId, params.TxHash)
 	if err != nil {
-		return fmt.Errorf("MultiSign, get txJsonInfo error: %v", err)
+		return false, fmt.Errorf("MultiSign, get txJsonInfo error: %v", err)
+	}
+	transfers, err := GetRipplePayment(service, raw)
+	if err != nil {
+		return false, fmt.Errorf("MultiSign, GetRipplePayment error: %v", err)
+	}
+	if transfers == nil {
+		transfers = &RipplePayment{
+			FromChainIDs: []uint64{params.FromChainId},
+			TxHashes:     [][]byte{params.TxHash},
+		}
+	}
+	return multiSign(service, rippleExtraInfo, params.ToChainId, transfers, raw, params.TxJson)
+}
+
+// MultiSignBatch collects signatures for all payments of a flushed batch in one call, it reports whether
+// any payment of the batch accepted a new signature
+func (this *RippleHandler) MultiSignBatch(service *contract.ModuleContract) (bool, error) {
+	ctx := service.ContractRef().CurrentContext()
+	params := &common.MultiSignBatchParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodMultiSignBatch, params, ctx.Payload); err != nil {
+		return false, fmt.Errorf("MultiSignBatch, contract params deserialize error: %v", err)
+	}
+	if err := side_chain_manager.CheckSourceStatus(service, params.ToChainId); err != nil {
+		return false, fmt.Errorf("MultiSignBatch, %v", err)
+	}
+
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.ToChainId)
+	if err != nil {
+		return false, fmt.Errorf("MultiSignBatch, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	info, err := GetRippleBatchInfo(service, params.ToChainId, params.BatchId)
+	if err != nil {
+		return false, fmt.Errorf("MultiSignBatch, GetRippleBatchInfo error: %v", err)
+	}
+	if len(params.TxJsons) != len(info.Payments) {
+		return false, fmt.Errorf("MultiSignBatch, expect %d tx json, got %d", len(info.Payments), len(params.TxJsons))
+	}
+	progress := false
+	for i, p := range info.Payments {
+		// empty entries leave the payment to a later round
+		if params.TxJsons[i] == "" {
+			continue
+		}
+		transfers := &RipplePayment{FromChainIDs: p.FromChainIDs, TxHashes: p.TxHashes}
+		added, err := multiSign(service, rippleExtraInfo, params.ToChainId, transfers, p.Raw, params.TxJsons[i])
+		if err != nil {
+			return false, fmt.Errorf("MultiSignBatch, payment %d: %v", i, err)
+		}
+		progress = progress || added
 	}
+	return progress, nil
+}
 
+// multiSign collects the signatures of a payment, once it is fully signed every transfer it pays out is
+// notified with the signed tx. It reports whether a signature not known before was added.
+func multiSign(service *contract.ModuleContract, rippleExtraInfo *side_chain_manager.RippleExtraInfo, toChainId uint64,
+	transfers *RipplePayment, raw, txJsonStr string) (bool, error) {
 	// check if aleady done
 	multisignInfo, err := GetMultisignInfo(service, raw)
 	if err != nil {
-		return fmt.Errorf("MultiSign, GetMultisignInfo error: %v", err)
+		return false, fmt.Errorf("MultiSign, GetMultisignInfo error: %v", err)
 	}
 	if multisignInfo.Status {
-		return nil
+		return false, nil
 	}
 
 	// check if signature is valid
+	added := false
 	txJson := new(types.MultisignPayment)
-	err = json.Unmarshal([]byte(params.TxJson), txJson)
+	err = json.Unmarshal([]byte(txJsonStr), txJson)
 	if err != nil {
-		return fmt.Errorf("MultiSign, unmarshal signed txjson error: %s", err)
+		return false, fmt.Errorf("MultiSign, unmarshal signed txjson error: %s", err)
 	}
 	for _, s := range txJson.Signers {
 		signerAccount, err := data.NewAccountFromAddress(s.Signer.Account)
 		if err != nil {
-			return fmt.Errorf("MultiSign, data.NewAccountFromAddress error: %s", err)
+			return false, fmt.Errorf("MultiSign, data.NewAccountFromAddress error: %s", err)
 		}
 		signerPk, err := hex.DecodeString(s.Signer.SigningPubKey)
 		if err != nil {
-			return fmt.Errorf("MultiSign, hex.DecodeString signer pk error: %s", err)
+			return false, fmt.Errorf("MultiSign, hex.DecodeString signer pk error: %s", err)
 		}
 		signature, err := hex.DecodeString(s.Signer.TxnSignature)
 		if err != nil {
-			return fmt.Errorf("MultiSign, hex.DecodeString signature error: %s", err)
+			return false, fmt.Errorf("MultiSign, hex.DecodeString signature error: %s", err)
 		}
 
 		// check if valid signer
@@ -157,13 +345,13 @@ func (this *RippleHandler) MultiSign(service *contract.ModuleContract) error {
 			}
 		}
 		if !flag {
-			return fmt.Errorf("MultiSign, signer is not multisign account")
+			return false, fmt.Errorf("MultiSign, signer is not multisign account")
 		}
 
 		//check if valid signature
 		err = types.CheckMultiSign(raw, *signerAccount, signerPk, signature)
 		if err != nil {
-			return fmt.Errorf("MultiSign, types.CheckMultiSign error: %s", err)
+			return false, fmt.Errorf("MultiSign, types.CheckMultiSign error: %s", err)
 		}
 		signer := &Signer{
 			Account:       signerAccount.Bytes(),
@@ -172,25 +360,33 @@ func (this *RippleHandler) MultiSign(service *contract.ModuleContract) error {
 		}
 		blob, err := rlp.EncodeToBytes(signer)
 		if err != nil {
-			return fmt.Errorf("MultiSign, rlp.EncodeToBytes signer error: %v", err)
+			return false, fmt.Errorf("MultiSign, rlp.EncodeToBytes signer error: %v", err)
+		}
+		key := hex.EncodeToString(blob)
+		if !multisignInfo.SigMap[key] {
+			multisignInfo.SigMap[key] = true
+			added = true
 		}
-		multisignInfo.SigMap[hex.EncodeToString(blob)] = true
+	}
+	// every signature is known already, nothing to store
+	if !added {
+		return false, nil
 	}
 
 	if uint64(len(multisignInfo.SigMap)) >= rippleExtraInfo.Quorum {
 		payment, err := types.DeserializeRawMultiSignTx(raw)
 		if err != nil {
-			return fmt.Errorf("MultiSign, types.DeserializeRawMultiSignTx error")
+			return false, fmt.Errorf("MultiSign, types.DeserializeRawMultiSignTx error")
 		}
 		for s := range multisignInfo.SigMap {
 			signerBytes, err := hex.DecodeString(s)
 			if err != nil {
-				return fmt.Errorf("MultiSign, hex.DecodeString signer bytes error")
+				return false, fmt.Errorf("MultiSign, hex.DecodeString signer bytes error")
 			}
 			signer := new(Signer)
 			err = rlp.DecodeBytes(signerBytes, signer)
 			if err != nil {
-				return fmt.Errorf("MultiSign, deserialization signer bytes error")
+				return false, fmt.Errorf("MultiSign, deserialization signer bytes error")
 			}
 			sig := data.Signer{}
 			sig.Signer.SigningPubKey = new(data.PublicKey)
@@ -205,33 +401,34 @@ func (this *RippleHandler) MultiSign(service *contract.ModuleContract) error {
 
 		finalPayment, err := json.Marshal(payment)
 		if err != nil {
-			return fmt.Errorf("MultiSign, json.Marshal final payment error: %s", err)
+			return false, fmt.Errorf("MultiSign, json.Marshal final payment error: %s", err)
 		}
-		err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventMultiSign}, params.FromChainId, params.ToChainId,
-			hex.EncodeToString(params.TxHash), string(finalPayment), payment.Sequence)
-		if err != nil {
-			return fmt.Errorf("MultiSign, AddNotify error: %v", err)
+		for i, fromChainId := range transfers.FromChainIDs {
+			err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventMultiSign}, fromChainId, toChainId,
+				hex.EncodeToString(transfers.TxHashes[i]), string(finalPayment), payment.Sequence)
+			if err != nil {
+				return false, fmt.Errorf("MultiSign, AddNotify error: %v", err)
+			}
 		}
 		multisignInfo.Status = true
 	}
 	if err := PutMultisignInfo(service, raw, multisignInfo); err != nil {
-		return fmt.Errorf("MultiSign, PutMultisignInfo error: %s", err)
+		return false, fmt.Errorf("MultiSign, PutMultisignInfo error: %s", err)
 	}
-	return nil
+	return true, nil
 }
 
 func (this *RippleHandler) MakeTransaction(service *contract.ModuleContract, param *common.MakeTxParam,
 	fromChainID uint64) error {
 	args, err := common.DecodeRippleTxArgs(param.Args)
 	if err != nil {
-		fmt.Errorf("ripple MakeTransaction, deserialize asset hash error")
+		return fmt.Errorf("ripple MakeTransaction, deserialize asset hash error")
 	}
-	toAddrBytes := args.ToAddress
-	amount_temp := args.Amount.Uint64()
-	amount, err := data.NewAmount(new(big.Int).SetUint64(amount_temp).String())
+	memos, err := toRippleMemos(args.Memos)
 	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, data.NewAmount error: %s", err)
+		return fmt.Errorf("ripple MakeTransaction, toRippleMemos error: %s", err)
 	}
+	toAddrBytes := args.ToAddress
 
 	//get asset map
 	assetBind, err := side_chain_manager.GetAssetBind(service, param.ToChainID)
@@ -252,6 +449,17 @@ func (this *RippleHandler) MakeTransaction(service *contract.ModuleContract, par
 			"toContractAddress: %x, lockProxyAddress: %x", assetAddress, param.ToContractAddress, lockProxyAddress)
 	}
 
+	// amounts of the source chain are converted to drops
+	args.Amount, err = side_chain_manager.ConvertAmount(service, param.ToChainID, assetAddress, fromChainID, args.Amount, false)
+	if err != nil {
+		return fmt.Errorf("ripple MakeTransaction, side_chain_manager.ConvertAmount error: %s", err)
+	}
+	amount_temp := args.Amount.Uint64()
+	amount, err := data.NewAmount(new(big.Int).SetUint64(amount_temp).String())
+	if err != nil {
+		return fmt.Errorf("ripple MakeTransaction, data.NewAmount error: %s", err)
+	}
+
 	// get rippleExtraInfo
 	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, param.ToChainID)
 	if err != nil {
@@ -289,12 +497,26 @@ func (this *RippleHandler) MakeTransaction(service *contract.ModuleContract, par
 		return fmt.Errorf("ripple MakeTransaction, amount is less than reserveAmount")
 	}
 
+	// the multisign account pays amountD plus fee, which is the full amount
+	if err := commitPayment(service, rippleExtraInfo, param.ToChainID, args.Amount); err != nil {
+		return fmt.Errorf("ripple MakeTransaction, commitPayment error: %s", err)
+	}
+	if err := PutRippleTxParam(service, fromChainID, param); err != nil {
+		return fmt.Errorf("ripple MakeTransaction, PutRippleTxParam error: %s", err)
+	}
+
+	if rippleExtraInfo.BatchInterval > 0 {
+		return queueTransfer(service, param, fromChainID, args)
+	}
+
 	from := new(data.Account)
 	to := new(data.Account)
 	copy(from[:], assetAddress)
 	copy(to[:], toAddrBytes)
 
 	payment := types.GeneratePayment(*from, *to, *amountD, *fee, uint32(rippleExtraInfo.Sequence))
+	payment.DestinationTag = args.DestinationTag
+	payment.Memos = memos
 	_, raw, err := data.Raw(payment)
 	if err != nil {
 		return fmt.Errorf("ripple MakeTransaction, data.Raw error: %s", err)
@@ -313,19 +535,230 @@ func (this *RippleHandler) MakeTransaction(service *contract.ModuleContract, par
 	}
 
 	//store txJson info
-	err = PutTxJsonInfo(service, fromChainID, param.TxHash, hex.EncodeToString(raw))
+	err = putPayment(service, hex.EncodeToString(raw), &RipplePayment{
+		FromChainIDs: []uint64{fromChainID},
+		TxHashes:     [][]byte{param.TxHash},
+		Amounts:      []*big.Int{args.Amount},
+	})
+	if err != nil {
+		return fmt.Errorf("ripple MakeTransaction, putPayment error: %s", err)
+	}
+	return nil
+}
+
+func queueTransfer(service *contract.ModuleContract, param *common.MakeTxParam, fromChainID uint64, args *common.RippleTxArgs) error {
+	batch, err := GetRippleBatch(service, param.ToChainID)
+	if err != nil {
+		return fmt.Errorf("ripple queueTransfer, GetRippleBatch error: %v", err)
+	}
+	if len(batch.Transfers) >= MAX_QUEUE_SIZE {
+		return fmt.Errorf("ripple queueTransfer, queue of chain %d is full", param.ToChainID)
+	}
+	transfer := &PendingTransfer{
+		FromChainID: fromChainID,
+		TxHash:      param.TxHash,
+		ToAddress:   args.ToAddress,
+		Amount:      args.Amount,
+		Memos:       args.Memos,
+	}
+	if args.DestinationTag != nil {
+		transfer.HasDestinationTag = true
+		transfer.DestinationTag = *args.DestinationTag
+	}
+	batch.Transfers = append(batch.Transfers, transfer)
+	if err := PutRippleBatch(service, param.ToChainID, batch); err != nil {
+		return fmt.Errorf("ripple queueTransfer, PutRippleBatch error: %v", err)
+	}
+	err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventRippleTxQueued}, fromChainID, param.ToChainID,
+		hex.EncodeToString(param.TxHash))
+	if err != nil {
+		return fmt.Errorf("ripple queueTransfer, AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// FlushBatch turns the queued transfers of a ripple chain into one batch of payments
+// once the batch interval has passed since the last flush
+func (this *RippleHandler) FlushBatch(service *contract.ModuleContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &common.FlushRippleBatchParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodFlushRippleBatch, params, ctx.Payload); err != nil {
+		return fmt.Errorf("FlushBatch, contract params deserialize error: %v", err)
+	}
+	if err := side_chain_manager.CheckSourceStatus(service, params.ToChainId); err != nil {
+		return fmt.Errorf("FlushBatch, %v", err)
+	}
+
+	rippleExtraInfo, err := side_chain_manager.GetRippleExtraInfo(service, params.ToChainId)
+	if err != nil {
+		return fmt.Errorf("FlushBatch, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	if rippleExtraInfo.BatchInterval == 0 {
+		return fmt.Errorf("FlushBatch, batch mode of chain %d is disabled", params.ToChainId)
+	}
+	batch, err := GetRippleBatch(service, params.ToChainId)
+	if err != nil {
+		return fmt.Errorf("FlushBatch, GetRippleBatch error: %v", err)
+	}
+	if len(batch.Transfers) == 0 {
+		return fmt.Errorf("FlushBatch, no pending transfer of chain %d", params.ToChainId)
+	}
+	height := service.ContractRef().BlockHeight().Uint64()
+	if height < batch.LastHeight+rippleExtraInfo.BatchInterval {
+		return fmt.Errorf("FlushBatch, next batch of chain %d starts at height %d", params.ToChainId,
+			batch.LastHeight+rippleExtraInfo.BatchInterval)
+	}
+
+	assetBind, err := side_chain_manager.GetAssetBind(service, params.ToChainId)
+	if err != nil {
+		return fmt.Errorf("FlushBatch, get asset map error: %v", err)
+	}
+	assetAddress, ok := assetBind.AssetMap[params.ToChainId]
+	if !ok {
+		return fmt.Errorf("FlushBatch, asset map of chain %d is not registered", params.ToChainId)
+	}
+	baseFee, err := side_chain_manager.GetFeeObj(service, params.ToChainId)
+	if err != nil {
+		return fmt.Errorf("FlushBatch, side_chain_manager.GetFee error: %v", err)
+	}
+	if baseFee.View == 0 {
+		return fmt.Errorf("FlushBatch, base fee is not initialized")
+	}
+	fee_temp := new(big.Int).Mul(baseFee.Fee, new(big.Int).SetUint64(rippleExtraInfo.SignerNum))
+	fee, err := data.NewValue(ToStringByPrecise(fee_temp, 6), true)
+	if err != nil {
+		return fmt.Errorf("FlushBatch, data.NewValue fee error: %s", err)
+	}
+	feeAmount, err := data.NewAmount(fee_temp.String())
+	if err != nil {
+		return fmt.Errorf("FlushBatch, data.NewAmount fee error: %s", err)
+	}
+
+	n := len(batch.Transfers)
+	if n > MAX_BATCH_SIZE {
+		n = MAX_BATCH_SIZE
+	}
+	from := new(data.Account)
+	copy(from[:], assetAddress)
+	info := &RippleBatchInfo{}
+	raws := make([]string, 0, n)
+	for _, group := range groupTransfers(batch.Transfers[:n]) {
+		sum := new(big.Int)
+		for _, t := range group {
+			sum.Add(sum, t.Amount)
+		}
+		amount, err := data.NewAmount(sum.String())
+		if err != nil {
+			return fmt.Errorf("FlushBatch, data.NewAmount error: %s", err)
+		}
+		amountD, err := amount.Subtract(feeAmount)
+		if err != nil {
+			return fmt.Errorf("FlushBatch, amount.Subtract fee error: %s", err)
+		}
+		memos, err := toRippleMemos(group[0].Memos)
+		if err != nil {
+			return fmt.Errorf("FlushBatch, toRippleMemos error: %s", err)
+		}
+		to := new(data.Account)
+		copy(to[:], group[0].ToAddress)
+
+		payment := types.GeneratePayment(*from, *to, *amountD, *fee, uint32(rippleExtraInfo.Sequence))
+		if group[0].HasDestinationTag {
+			tag := group[0].DestinationTag
+			payment.DestinationTag = &tag
+		}
+		payment.Memos = memos
+		_, raw, err := data.Raw(payment)
+		if err != nil {
+			return fmt.Errorf("FlushBatch, data.Raw error: %s", err)
+		}
+		rippleExtraInfo.Sequence = rippleExtraInfo.Sequence + 1
+
+		p := &BatchPayment{Raw: hex.EncodeToString(raw)}
+		transfers := new(RipplePayment)
+		for _, t := range group {
+			p.FromChainIDs = append(p.FromChainIDs, t.FromChainID)
+			p.TxHashes = append(p.TxHashes, t.TxHash)
+			transfers.FromChainIDs = append(transfers.FromChainIDs, t.FromChainID)
+			transfers.TxHashes = append(transfers.TxHashes, t.TxHash)
+			transfers.Amounts = append(transfers.Amounts, t.Amount)
+		}
+		if err := putPayment(service, p.Raw, transfers); err != nil {
+			return fmt.Errorf("FlushBatch, putPayment error: %s", err)
+		}
+		info.Payments = append(info.Payments, p)
+		raws = append(raws, p.Raw)
+	}
+
+	batchID := batch.NextBatchID
+	if err := PutRippleBatchInfo(service, params.ToChainId, batchID, info); err != nil {
+		return fmt.Errorf("FlushBatch, PutRippleBatchInfo error: %v", err)
+	}
+	batch.Transfers = batch.Transfers[n:]
+	batch.LastHeight = height
+	batch.NextBatchID = batchID + 1
+	if err := PutRippleBatch(service, params.ToChainId, batch); err != nil {
+		return fmt.Errorf("FlushBatch, PutRippleBatch error: %v", err)
+	}
+	if err := side_chain_manager.PutRippleExtraInfo(service, params.ToChainId, rippleExtraInfo); err != nil {
+		return fmt.Errorf("FlushBatch, side_chain_manager.PutRippleExtraInfo error: %s", err)
+	}
+	err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventRippleBatch}, params.ToChainId, batchID, raws)
 	if err != nil {
-		return fmt.Errorf("ripple MakeTransaction, PutTxJsonInfo error: %s", err)
+		return fmt.Errorf("FlushBatch, AddNotify error: %v", err)
 	}
 	return nil
 }
 
+// GetTxStatus reports the signing status of a single outbound transfer
+func GetTxStatus(service *contract.ModuleContract, fromChainId, toChainId uint64, txHash []byte) (uint8, error) {
+	raw, err := getTxJsonInfo(service, fromChainId, txHash)
+	if err != nil {
+		return TX_STATUS_UNKNOWN, fmt.Errorf("GetTxStatus, getTxJsonInfo error: %v", err)
+	}
+	if raw == "" {
+		batch, err := GetRippleBatch(service, toChainId)
+		if err != nil {
+			return TX_STATUS_UNKNOWN, fmt.Errorf("GetTxStatus, GetRippleBatch error: %v", err)
+		}
+		for _, t := range batch.Transfers {
+			if t.FromChainID == fromChainId && bytes.Equal(t.TxHash, txHash) {
+				return TX_STATUS_QUEUED, nil
+			}
+		}
+		return TX_STATUS_UNKNOWN, nil
+	}
+	multisignInfo, err := GetMultisignInfo(service, raw)
+	if err != nil {
+		return TX_STATUS_UNKNOWN, fmt.Errorf("GetTxStatus, GetMultisignInfo error: %v", err)
+	}
+	if !multisignInfo.Status {
+		return TX_STATUS_SIGNING, nil
+	}
+	result, err := GetRippleTxResult(service, raw)
+	if err != nil {
+		return TX_STATUS_UNKNOWN, fmt.Errorf("GetTxStatus, GetRippleTxResult error: %v", err)
+	}
+	if result != nil {
+		switch result.Action {
+		case RESULT_SETTLE:
+			return TX_STATUS_CONFIRMED, nil
+		case RESULT_REFUND:
+			return TX_STATUS_REFUNDED, nil
+		}
+	}
+	return TX_STATUS_SIGNED, nil
+}
+
 func (this *RippleHandler) ReconstructTx(service *contract.ModuleContract) error {
 	ctx := service.ContractRef().CurrentContext()
 	params := &common.ReconstructTxParam{}
 	if err := contract.UnpackMethod(common.ABI, common.MethodReconstructRippleTx, params, ctx.Payload); err != nil {
 		return fmt.Errorf("ReconstructTx, contract params deserialize error: %v", err)
 	}
+	if err := side_chain_manager.CheckSourceStatus(service, params.ToChainId); err != nil {
+		return fmt.Errorf("ReconstructTx, %v", err)
+	}
 
 	//get tx json info
 	raw, err := GetTxJsonInfo(service, params.FromChainId, params.TxHash)
@@ -360,13 +793,49 @@ func (this *RippleHandler) ReconstructTx(service *contract.ModuleContract) error
 		return fmt.Errorf("ReconstructTx, data.NewValue fee error: %s", err)
 	}
 
+	// a payment with a known ledger result is settled or reissued already
+	result, err := GetRippleTxResult(service, raw)
+	if err != nil {
+		return fmt.Errorf("ReconstructTx, GetRippleTxResult error: %v", err)
+	}
+	if result != nil {
+		return fmt.Errorf("ReconstructTx, payment is already confirmed with %s", result.Result)
+	}
+	transfers, err := GetRipplePayment(service, raw)
+	if err != nil {
+		return fmt.Errorf("ReconstructTx, GetRipplePayment error: %v", err)
+	}
+	// a higher fee is paid from the vault on top of the committed amount, a payment signed before
+	// the vault existed commits all of it since it is recorded from now on
+	delta := new(big.Int).Sub(fee_temp, ToIntByPrecise(payment.Fee.String(), 6))
+	if transfers == nil {
+		transfers = &RipplePayment{
+			FromChainIDs: []uint64{params.FromChainId},
+			TxHashes:     [][]byte{params.TxHash},
+			Amounts:      []*big.Int{paymentDrops(payment)},
+		}
+		delta.Add(delta, transfers.Amounts[0])
+	}
+	if err := commitPayment(service, rippleExtraInfo, params.ToChainId, delta); err != nil {
+		return fmt.Errorf("ReconstructTx, commitPayment error: %v", err)
+	}
+
+	// the transfers are bound to the raw tx with the new fee, which is signed and confirmed from now on
 	payment.Fee = *fee
+	_, reconstructed, err := data.Raw(payment)
+	if err != nil {
+		return fmt.Errorf("ReconstructTx, data.Raw error: %v", err)
+	}
+	if err := putPayment(service, hex.EncodeToString(reconstructed), transfers); err != nil {
+		return fmt.Errorf("ReconstructTx, putPayment error: %v", err)
+	}
+	// relayers parse the reconstructed payment of this event as json
 	txJsonStr, err := json.Marshal(payment)
 	if err != nil {
 		return fmt.Errorf("ReconstructTx, json.Marshal tx json error: %v", err)
 	}
 	err = service.AddNotify(common.ABI, []string{cross_chain_manager_abi.EventRippleTx}, params.FromChainId, params.ToChainId,
-		hex.EncodeToString(params.TxHash), txJsonStr, payment.Sequence)
+		hex.EncodeToString(params.TxHash), string(txJsonStr), payment.Sequence)
 	if err != nil {
 		return fmt.Errorf("ReconstructTx, AddNotify error: %v", err)
 	}
@@ -1,14 +1,36 @@
 package ripple
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"github.com/polynetwork/ripple-sdk/types"
-	"github.com/stretchr/testify/assert"
 	"math/big"
 	"testing"
+
+	common2 "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/ripple-sdk/types"
+	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/polynetwork/zion-example/modules/info_sync"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+	"github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/assert"
 )
 
+var sdb *state.StateDB
+
+func init() {
+	info_sync.InitInfoSync()
+	sdb = contract.NewTestStateDB()
+}
+
+func newTestService() *contract.ModuleContract {
+	contractRef := contract.NewContractRef(sdb, common2.EmptyAddress, common2.EmptyAddress, big.NewInt(1), common2.Hash{}, 0, nil)
+	return contract.NewModuleContract(sdb, contractRef)
+}
+
 func TestJsonMarshall(t *testing.T) {
 	txJson := "{\"TransactionType\":\"Payment\",\"Account\":\"rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9\",\"Sequence\":25336393,\"Fee\":\"150\",\"SigningPubKey\":\"\",\"Signers\":[{\"Account\":\"rLi6oSF38EdP7mzhdccyxhfd8vp8FWbsWF\",\"TxnSignature\":\"3044022048B1FD1B48B149B9E7A66344F758E7992C331D5EFE9A81F3F4D52477C5DBEBD50220453DC7B5A4E617CC59B15F887A7579F2B0BA8F14A65A6C416EB7C1D8A610204A\",\"SigningPubKey\":\"038B71C30DF7D4E9259732247AF169CCFACA1C0210784CEBD2884C0003B91CF33A\"}],\"Memos\":[{\"Memo\":{\"MemoType\":\"706F6C7968617368\",\"MemoData\":\"3E7C59E3954DEE9116A8148EC5CDCDB22485D55A62161B892F68ABDE4BF1A618\",\"MemoFormat\":\"\"}}],\"hash\":\"0000000000000000000000000000000000000000000000000000000000000000\",\"Destination\":\"rT4vRkeJsgaq7t6TVJJPsbrQp5oKMGRfN\",\"Amount\":\"1000000\"}"
 	payment := new(types.MultisignPayment)
@@ -23,4 +45,332 @@ func TestStringPrecise(t *testing.T) {
 	fee_temp := new(big.Int).SetUint64(150)
 	fee := ToStringByPrecise(fee_temp, 6)
 	assert.Equal(t, fee, "0.00015")
-}
\ No newline at end of file
+}
+
+func TestPaymentTagAndMemos(t *testing.T) {
+	from, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	to, err := data.NewAccountFromAddress("rT4vRkeJsgaq7t6TVJJPsbrQp5oKMGRfN")
+	assert.Nil(t, err)
+	amount, err := data.NewAmount("1000000")
+	assert.Nil(t, err)
+	fee, err := data.NewValue("0.00015", true)
+	assert.Nil(t, err)
+
+	tag := uint32(123456)
+	memos, err := toRippleMemos([]common.RippleMemo{{MemoType: []byte("zionhash"), MemoData: []byte{1, 2, 3}}})
+	assert.Nil(t, err)
+	payment := types.GeneratePayment(*from, *to, *amount, *fee, 1)
+	payment.DestinationTag = &tag
+	payment.Memos = memos
+	_, raw, err := data.Raw(payment)
+	assert.Nil(t, err)
+
+	multisignPayment, err := types.DeserializeRawMultiSignTx(hex.EncodeToString(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, tag, *multisignPayment.DestinationTag)
+	assert.Equal(t, 1, len(multisignPayment.Memos))
+	assert.Equal(t, []byte("zionhash"), []byte(multisignPayment.Memos[0].Memo.MemoType))
+	assert.Equal(t, []byte{1, 2, 3}, []byte(multisignPayment.Memos[0].Memo.MemoData))
+
+	_, err = toRippleMemos([]common.RippleMemo{{MemoData: make([]byte, MAX_MEMOS_SIZE+1)}})
+	assert.NotNil(t, err)
+}
+
+func TestVerifyTxProof(t *testing.T) {
+	tx := []byte("synthetic transaction")
+	meta := []byte("synthetic metadata")
+	txID := sha512Half(data.HP_TRANSACTION_ID, tx)
+	leaf, err := txLeafHash(txID, tx, meta)
+	assert.Nil(t, err)
+
+	inner1 := make([]byte, INNER_NODE_SIZE)
+	pos := int(nibble(txID, 1))
+	copy(inner1[pos*32:], leaf[:])
+	copy(inner1[((pos+1)%16)*32:], []byte("sibling"))
+	node1 := sha512Half(data.HP_INNER_NODE, inner1)
+	inner0 := make([]byte, INNER_NODE_SIZE)
+	pos = int(nibble(txID, 0))
+	copy(inner0[pos*32:], node1[:])
+	root := sha512Half(data.HP_INNER_NODE, inner0)
+
+	proof := &RippleTxProof{Tx: tx, Meta: meta, Path: [][]byte{inner0, inner1}}
+	id, err := VerifyTxProof(proof, root)
+	assert.Nil(t, err)
+	assert.Equal(t, txID, id)
+
+	proof.Meta = []byte("tampered metadata")
+	_, err = VerifyTxProof(proof, root)
+	assert.NotNil(t, err)
+
+	proof.Meta = meta
+	proof.Path = [][]byte{inner1}
+	_, err = VerifyTxProof(proof, root)
+	assert.NotNil(t, err)
+}
+
+func TestDepositByProof(t *testing.T) {
+	const chainID, toChainID uint64 = 41, 2
+	service := newTestService()
+	handler := NewRippleHandler()
+
+	from, err := data.NewAccountFromAddress("rT4vRkeJsgaq7t6TVJJPsbrQp5oKMGRfN")
+	assert.Nil(t, err)
+	vault, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	lockProxy := []byte{0x33, 0x44}
+	err = side_chain_manager.PutAssetBind(service, chainID, &side_chain_manager.AssetBind{
+		AssetMap:     map[uint64][]byte{chainID: vault[:]},
+		LockProxyMap: map[uint64][]byte{toChainID: lockProxy},
+	})
+	assert.Nil(t, err)
+
+	// a payment to the multisign account, its leaf is the only child of the root of the transaction tree
+	amount, err := data.NewAmount("1000000")
+	assert.Nil(t, err)
+	fee, err := data.NewValue("0.00001", true)
+	assert.Nil(t, err)
+	memo, err := json.Marshal(&RippleDepositMemo{ToChainID: toChainID, ToAddress: "0x1234"})
+	assert.Nil(t, err)
+	payment := types.GeneratePayment(*from, *vault, *amount, *fee, 1)
+	payment.Memos, err = toRippleMemos([]common.RippleMemo{{MemoData: memo}})
+	assert.Nil(t, err)
+	txID, tx, err := data.Raw(payment)
+	assert.Nil(t, err)
+	leaf, node, err := data.Raw(&data.TransactionWithMetaData{Transaction: payment})
+	assert.Nil(t, err)
+	// the transaction node is the length prefixed tx and metadata followed by the tx id
+	rest := node[1+len(tx):]
+	meta := rest[1 : len(rest)-32]
+	assert.Equal(t, byte(len(meta)), rest[0])
+	inner := make([]byte, INNER_NODE_SIZE)
+	pos := int(nibble(txID, 0))
+	copy(inner[pos*32:], leaf[:])
+	proof := &RippleTxProof{Tx: tx, Meta: meta, Path: [][]byte{inner}}
+
+	syncLedger := func(sequence uint32, root data.Hash256) {
+		_, header, err := data.Raw(&data.Ledger{LedgerHeader: data.LedgerHeader{LedgerSequence: sequence, TransactionHash: root}})
+		assert.Nil(t, err)
+		assert.Nil(t, info_sync.PutRootInfo(service, chainID, uint64(sequence), header))
+	}
+	syncLedger(100, sha512Half(data.HP_INNER_NODE, inner))
+	syncLedger(101, sha512Half(data.HP_INNER_NODE, make([]byte, INNER_NODE_SIZE)))
+	deposit := func(height uint64, proof *RippleTxProof) (*common.MakeTxParam, error) {
+		raw, err := rlp.EncodeToBytes(proof)
+		assert.Nil(t, err)
+		return handler.makeDepositProposalByProof(service, &common.EntranceParam{SourceChainID: chainID, Height: height, Proof: raw})
+	}
+
+	// a tampered proof, a proof against another ledger and a ledger not synced are refused
+	_, err = deposit(100, &RippleTxProof{Tx: tx, Meta: []byte("tampered metadata"), Path: proof.Path})
+	assert.NotNil(t, err)
+	_, err = deposit(101, proof)
+	assert.NotNil(t, err)
+	_, err = deposit(102, proof)
+	assert.NotNil(t, err)
+
+	txParam, err := deposit(100, proof)
+	assert.Nil(t, err)
+	assert.Equal(t, txID[:], txParam.TxHash)
+	assert.Equal(t, toChainID, txParam.ToChainID)
+	assert.Equal(t, lockProxy, txParam.ToContractAddress)
+	assert.Equal(t, UNLOCK_METHOD, txParam.Method)
+	args, err := common.DecodeRippleTxArgs(txParam.Args)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x12, 0x34}, args.ToAddress)
+	assert.Equal(t, big.NewInt(1000000), args.Amount)
+	ledgerVault, err := GetRippleVault(service, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000000), ledgerVault.Deposited)
+
+	// a deposit is only imported once
+	_, err = deposit(100, proof)
+	assert.NotNil(t, err)
+}
+
+func TestGroupTransfers(t *testing.T) {
+	addr1, addr2 := []byte{1}, []byte{2}
+	transfers := []*PendingTransfer{
+		{TxHash: []byte{1}, ToAddress: addr1, Amount: big.NewInt(1)},
+		{TxHash: []byte{2}, ToAddress: addr2, Amount: big.NewInt(2)},
+		{TxHash: []byte{3}, ToAddress: addr1, Amount: big.NewInt(3)},
+		{TxHash: []byte{4}, ToAddress: addr1, Amount: big.NewInt(4), HasDestinationTag: true, DestinationTag: 9},
+		{TxHash: []byte{5}, ToAddress: addr1, Amount: big.NewInt(5), Memos: []common.RippleMemo{{MemoData: []byte{1}}}},
+		{TxHash: []byte{6}, ToAddress: addr1, Amount: big.NewInt(6), Memos: []common.RippleMemo{{MemoData: []byte{1}}}},
+	}
+	groups := groupTransfers(transfers)
+	assert.Equal(t, 5, len(groups))
+	assert.Equal(t, 2, len(groups[0]))
+	assert.Equal(t, []byte{3}, groups[0][1].TxHash)
+	assert.Equal(t, []byte{2}, groups[1][0].TxHash)
+	assert.Equal(t, []byte{4}, groups[2][0].TxHash)
+	assert.Equal(t, []byte{5}, groups[3][0].TxHash)
+	assert.Equal(t, []byte{6}, groups[4][0].TxHash)
+}
+
+func TestRippleVault(t *testing.T) {
+	vault := &RippleVault{
+		Deposited: big.NewInt(100000000),
+		Withdrawn: new(big.Int),
+		Fees:      new(big.Int),
+		Pending:   new(big.Int),
+	}
+	reserve := big.NewInt(10000000)
+
+	assert.Nil(t, vault.commit(big.NewInt(80000000), reserve))
+	assert.True(t, vault.IsSolvent(reserve))
+	assert.NotNil(t, vault.commit(big.NewInt(20000000), reserve))
+	assert.Equal(t, int64(80000000), vault.Pending.Int64())
+	// without reserve only the ledger can refuse the payment
+	assert.Nil(t, vault.commit(big.NewInt(20000000), nil))
+	assert.False(t, vault.IsSolvent(reserve))
+	assert.Nil(t, vault.commit(big.NewInt(-20000000), reserve))
+
+	assert.Nil(t, vault.settle(big.NewInt(80000000), big.NewInt(79999850), big.NewInt(150)))
+	assert.Equal(t, int64(20000000), vault.Balance().Int64())
+	assert.Equal(t, int64(0), vault.Pending.Int64())
+	assert.Equal(t, int64(150), vault.Fees.Int64())
+	assert.NotNil(t, vault.settle(big.NewInt(1), new(big.Int), new(big.Int)))
+
+	// a vault of a live chain starts empty and is seeded with the account balance
+	vault.reconcile(big.NewInt(500000000))
+	assert.Equal(t, int64(500000000), vault.Balance().Int64())
+	assert.Equal(t, int64(79999850), vault.Withdrawn.Int64())
+	assert.Equal(t, int64(150), vault.Fees.Int64())
+	vault.reconcile(big.NewInt(400000000))
+	assert.Equal(t, int64(400000000), vault.Balance().Int64())
+	assert.True(t, vault.IsSolvent(reserve))
+}
+
+func TestClassifyResult(t *testing.T) {
+	cases := map[string]uint8{
+		"tesSUCCESS":          RESULT_SETTLE,
+		"tecUNFUNDED_PAYMENT": RESULT_REFUND,
+		"tecNO_DST_INSUF_XRP": RESULT_REFUND,
+		"temMALFORMED":        RESULT_REFUND,
+		"tefPAST_SEQ":         RESULT_RECONSTRUCT,
+		"terPRE_SEQ":          RESULT_RECONSTRUCT,
+		"telINSUF_FEE_P":      RESULT_RECONSTRUCT,
+	}
+	for token, action := range cases {
+		var result data.TransactionResult
+		assert.Nil(t, result.UnmarshalText([]byte(token)))
+		assert.Equal(t, action, classifyResult(result), token)
+	}
+}
+
+func TestSplitRefund(t *testing.T) {
+	refunds := splitRefund([]*big.Int{big.NewInt(1000000)}, big.NewInt(150))
+	assert.Equal(t, int64(999850), refunds[0].Int64())
+
+	refunds = splitRefund([]*big.Int{big.NewInt(1000000), big.NewInt(3000000)}, big.NewInt(100))
+	assert.Equal(t, int64(999975), refunds[0].Int64())
+	assert.Equal(t, int64(2999925), refunds[1].Int64())
+
+	refunds = splitRefund([]*big.Int{big.NewInt(50)}, big.NewInt(150))
+	assert.Equal(t, int64(0), refunds[0].Int64())
+}
+
+func TestRebuildPayment(t *testing.T) {
+	from, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	to, err := data.NewAccountFromAddress("rT4vRkeJsgaq7t6TVJJPsbrQp5oKMGRfN")
+	assert.Nil(t, err)
+	amount, err := data.NewAmount("999850")
+	assert.Nil(t, err)
+	fee, err := data.NewValue("0.00015", true)
+	assert.Nil(t, err)
+	memos, err := toRippleMemos([]common.RippleMemo{{MemoData: []byte("refund")}})
+	assert.Nil(t, err)
+	tag := uint32(12345)
+	payment := types.GeneratePayment(*from, *to, *amount, *fee, 10)
+	payment.DestinationTag = &tag
+	payment.Memos = memos
+	_, raw, err := data.Raw(payment)
+	assert.Nil(t, err)
+
+	// a payment failed with tefPAST_SEQ is issued again from its raw tx
+	failed, err := types.DeserializeRawMultiSignTx(hex.EncodeToString(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1000000), paymentDrops(failed).Int64())
+	rebuilt, err := rebuildPayment(failed, 11, big.NewInt(300))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(11), rebuilt.Sequence)
+	assert.Equal(t, int64(1000000), paymentDrops(rebuilt).Int64())
+	assert.Equal(t, "0.9997", rebuilt.Amount.Value.String())
+	assert.Equal(t, tag, *rebuilt.DestinationTag)
+	assert.Equal(t, 1, len(rebuilt.Memos))
+	assert.Equal(t, to.String(), rebuilt.Destination.String())
+
+	_, err = rebuildPayment(failed, 11, big.NewInt(1000000))
+	assert.NotNil(t, err)
+}
+
+func TestRefundTxParams(t *testing.T) {
+	const chainID, fromChainID uint64 = 40, 3
+	service := newTestService()
+
+	vault, err := data.NewAccountFromAddress("rsHYGX2AoQ4tXqFywzEeeTDgXFTUfL1Fw9")
+	assert.Nil(t, err)
+	lockProxy := []byte{0x33, 0x44}
+	err = side_chain_manager.PutAssetBind(service, chainID, &side_chain_manager.AssetBind{
+		AssetMap:     map[uint64][]byte{chainID: vault[:]},
+		LockProxyMap: map[uint64][]byte{fromChainID: lockProxy},
+	})
+	assert.Nil(t, err)
+	transfers := &RipplePayment{
+		FromChainIDs: []uint64{fromChainID, fromChainID},
+		TxHashes:     [][]byte{{1}, {2}},
+		Amounts:      []*big.Int{big.NewInt(1000000), big.NewInt(3000000)},
+	}
+	// the first request carries the account of its user, the second is in the plain layout
+	user := common2.HexToAddress("0xaa").Bytes()
+	withSender, err := common.EncodeRippleTxArgs(&common.RippleTxArgs{ToAddress: vault[:], Amount: big.NewInt(1000000), Sender: user})
+	assert.Nil(t, err)
+	plain, err := common.EncodeRippleTxArgs(&common.RippleTxArgs{ToAddress: vault[:], Amount: big.NewInt(3000000)})
+	assert.Nil(t, err)
+	assert.Nil(t, PutRippleTxParam(service, fromChainID, &common.MakeTxParam{TxHash: []byte{1}, FromContractAddress: lockProxy, Args: withSender}))
+
+	// a transfer can only be refunded once its request is known
+	_, err = refundTxParams(service, chainID, "raw", transfers, big.NewInt(100))
+	assert.NotNil(t, err)
+	assert.Nil(t, PutRippleTxParam(service, fromChainID, &common.MakeTxParam{TxHash: []byte{2}, FromContractAddress: lockProxy, Args: plain}))
+	params, err := refundTxParams(service, chainID, "raw", transfers, big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(params))
+	for i, amount := range []int64{999975, 2999925} {
+		assert.Equal(t, transfers.TxHashes[i], params[i].TxHash)
+		assert.Equal(t, fromChainID, params[i].ToChainID)
+		assert.Equal(t, lockProxy, params[i].ToContractAddress)
+		assert.Equal(t, REFUND_METHOD, params[i].Method)
+		args, err := common.DecodeRippleTxArgs(params[i].Args)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(amount), args.Amount)
+		// the user is refunded, never the lock proxy that made the request
+		if i == 0 {
+			assert.Equal(t, user, args.ToAddress)
+		} else {
+			assert.Equal(t, 0, len(args.ToAddress))
+		}
+	}
+}
+
+func TestMultiSignWithoutProgress(t *testing.T) {
+	service := newTestService()
+	rippleExtraInfo := &side_chain_manager.RippleExtraInfo{Quorum: 1}
+	transfers := &RipplePayment{FromChainIDs: []uint64{3}, TxHashes: [][]byte{{1}}}
+
+	// a tx json carrying no signature adds nothing
+	added, err := multiSign(service, rippleExtraInfo, 40, transfers, "aa", "{}")
+	assert.Nil(t, err)
+	assert.False(t, added)
+	info, err := GetMultisignInfo(service, "aa")
+	assert.Nil(t, err)
+	assert.False(t, info.Status)
+
+	// a completed payment accepts no more signatures
+	assert.Nil(t, PutMultisignInfo(service, "bb", &MultisignInfo{Status: true, SigMap: map[string]bool{"01": true}}))
+	added, err = multiSign(service, rippleExtraInfo, 40, transfers, "bb", "{}")
+	assert.Nil(t, err)
+	assert.False(t, added)
+}
@@ -19,7 +19,9 @@ package ripple
 
 import (
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
 	"io"
+	"math/big"
 	"sort"
 )
 
@@ -64,3 +66,66 @@ type Signer struct {
 	TxnSignature  []byte
 	SigningPubKey []byte
 }
+
+type PendingTransfer struct {
+	FromChainID       uint64
+	TxHash            []byte
+	ToAddress         []byte
+	Amount            *big.Int
+	HasDestinationTag bool
+	DestinationTag    uint32
+	Memos             []common.RippleMemo
+}
+
+// RippleBatch is the queue of outbound transfers waiting to be flushed to a ripple chain
+type RippleBatch struct {
+	LastHeight  uint64
+	NextBatchID uint64
+	Transfers   []*PendingTransfer
+}
+
+// BatchPayment is one payment of a flushed batch and the transfers it pays out
+type BatchPayment struct {
+	Raw          string
+	FromChainIDs []uint64
+	TxHashes     [][]byte
+}
+
+type RippleBatchInfo struct {
+	Payments []*BatchPayment
+}
+
+// RippleVault tracks the xrp held by the multisign account of a ripple chain, all amounts in drops
+type RippleVault struct {
+	Deposited *big.Int
+	Withdrawn *big.Int
+	Fees      *big.Int
+	// committed to outbound payments whose ledger result is not known yet, fees included
+	Pending *big.Int
+}
+
+// Balance is the xrp held by the multisign account as seen by zion
+func (this *RippleVault) Balance() *big.Int {
+	balance := new(big.Int).Sub(this.Deposited, this.Withdrawn)
+	return balance.Sub(balance, this.Fees)
+}
+
+// Available is the balance not yet committed to pending payments
+func (this *RippleVault) Available() *big.Int {
+	return new(big.Int).Sub(this.Balance(), this.Pending)
+}
+
+// RipplePayment lists the transfers paid out by one outbound payment, amounts in drops
+type RipplePayment struct {
+	FromChainIDs []uint64
+	TxHashes     [][]byte
+	Amounts      []*big.Int
+}
+
+// RippleTxResult is the ledger result of an outbound payment attested by voters
+type RippleTxResult struct {
+	Result    string
+	Delivered *big.Int
+	Fee       *big.Int
+	Action    uint8
+}
@@ -18,6 +18,7 @@
 package ripple
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
@@ -27,6 +28,36 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
 	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/rubblelabs/ripple/data"
+)
+
+const (
+	// xrpl rejects transactions whose memos exceed 1KB
+	MAX_MEMOS_SIZE = 1024
+	// method called on the target lock proxy for proven deposits
+	UNLOCK_METHOD = "unlock"
+	// max queued transfers per ripple chain
+	MAX_QUEUE_SIZE = 1000
+	// max transfers flushed in one batch
+	MAX_BATCH_SIZE = 50
+	// method called on the source lock proxy for payments refused by the ledger
+	REFUND_METHOD = "refund"
+)
+
+const (
+	TX_STATUS_UNKNOWN uint8 = iota
+	TX_STATUS_QUEUED
+	TX_STATUS_SIGNING
+	TX_STATUS_SIGNED
+	TX_STATUS_CONFIRMED
+	TX_STATUS_REFUNDED
+)
+
+// what zion does once the ledger result of a payment is known
+const (
+	RESULT_SETTLE uint8 = iota
+	RESULT_RECONSTRUCT
+	RESULT_REFUND
 )
 
 func PutMultisignInfo(module *contract.ModuleContract, id string, multisignInfo *MultisignInfo) error {
@@ -70,16 +101,131 @@ func PutTxJsonInfo(module *contract.ModuleContract, fromChainId uint64, txHash [
 }
 
 func GetTxJsonInfo(module *contract.ModuleContract, fromChainId uint64, txHash []byte) (string, error) {
+	txJson, err := getTxJsonInfo(module, fromChainId, txHash)
+	if err != nil {
+		return "", fmt.Errorf("GetTxJsonInfo, get multisign info store error: %v", err)
+	}
+	if txJson == "" {
+		return "", fmt.Errorf("GetTxJsonInfo, can not find any record")
+	}
+	return txJson, nil
+}
+
+func getTxJsonInfo(module *contract.ModuleContract, fromChainId uint64, txHash []byte) (string, error) {
 	chainIdBytes := utils.GetUint64Bytes(fromChainId)
 	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_TX_INFO), chainIdBytes, txHash)
 	store, err := module.GetCacheDB().Get(key)
 	if err != nil {
-		return "", fmt.Errorf("GetTxJsonInfo, get multisign info store error: %v", err)
+		return "", err
+	}
+	return string(store), nil
+}
+
+func PutRippleBatch(module *contract.ModuleContract, toChainId uint64, batch *RippleBatch) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH), utils.GetUint64Bytes(toChainId))
+	blob, err := rlp.EncodeToBytes(batch)
+	if err != nil {
+		return fmt.Errorf("PutRippleBatch, rlp.EncodeToBytes batch error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+func GetRippleBatch(module *contract.ModuleContract, toChainId uint64) (*RippleBatch, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH), utils.GetUint64Bytes(toChainId))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleBatch, get batch store error: %v", err)
+	}
+	batch := &RippleBatch{
+		Transfers: make([]*PendingTransfer, 0),
+	}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, batch); err != nil {
+			return nil, fmt.Errorf("GetRippleBatch, deserialize batch error: %v", err)
+		}
+	}
+	return batch, nil
+}
+
+func PutRippleBatchInfo(module *contract.ModuleContract, toChainId, batchId uint64, info *RippleBatchInfo) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH_INFO),
+		utils.GetUint64Bytes(toChainId), utils.GetUint64Bytes(batchId))
+	blob, err := rlp.EncodeToBytes(info)
+	if err != nil {
+		return fmt.Errorf("PutRippleBatchInfo, rlp.EncodeToBytes batch info error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+func GetRippleBatchInfo(module *contract.ModuleContract, toChainId, batchId uint64) (*RippleBatchInfo, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH_INFO),
+		utils.GetUint64Bytes(toChainId), utils.GetUint64Bytes(batchId))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleBatchInfo, get batch info store error: %v", err)
 	}
 	if store == nil {
-		return "", fmt.Errorf("GetTxJsonInfo, can not find any record")
+		return nil, fmt.Errorf("GetRippleBatchInfo, batch %d of chain %d is not exist", batchId, toChainId)
 	}
-	return string(store), nil
+	info := new(RippleBatchInfo)
+	if err := rlp.DecodeBytes(store, info); err != nil {
+		return nil, fmt.Errorf("GetRippleBatchInfo, deserialize batch info error: %v", err)
+	}
+	return info, nil
+}
+
+// groupTransfers merges queued transfers paying the same destination into one payment,
+// transfers carrying memos are always paid on their own
+func groupTransfers(transfers []*PendingTransfer) [][]*PendingTransfer {
+	groups := make([][]*PendingTransfer, 0, len(transfers))
+	index := make(map[string]int)
+	for _, t := range transfers {
+		if len(t.Memos) != 0 {
+			groups = append(groups, []*PendingTransfer{t})
+			continue
+		}
+		k := fmt.Sprintf("%x/%v/%d", t.ToAddress, t.HasDestinationTag, t.DestinationTag)
+		if i, ok := index[k]; ok {
+			groups[i] = append(groups[i], t)
+			continue
+		}
+		index[k] = len(groups)
+		groups = append(groups, []*PendingTransfer{t})
+	}
+	return groups
+}
+
+func toRippleMemos(memos []common.RippleMemo) (data.Memos, error) {
+	if len(memos) == 0 {
+		return nil, nil
+	}
+	size := 0
+	result := make(data.Memos, 0, len(memos))
+	for _, v := range memos {
+		size += len(v.MemoType) + len(v.MemoData) + len(v.MemoFormat)
+		memo := data.Memo{}
+		memo.Memo.MemoType = v.MemoType
+		memo.Memo.MemoData = v.MemoData
+		memo.Memo.MemoFormat = v.MemoFormat
+		result = append(result, memo)
+	}
+	if size > MAX_MEMOS_SIZE {
+		return nil, fmt.Errorf("memos size %d exceeds limit %d", size, MAX_MEMOS_SIZE)
+	}
+	return result, nil
+}
+
+func decodeDepositMemo(memos data.Memos) (*RippleDepositMemo, error) {
+	for _, v := range memos {
+		memo := new(RippleDepositMemo)
+		if err := json.Unmarshal(v.Memo.MemoData, memo); err != nil {
+			continue
+		}
+		if memo.ToChainID != 0 && memo.ToAddress != "" {
+			return memo, nil
+		}
+	}
+	return nil, fmt.Errorf("no deposit memo found")
 }
 
 func ToStringByPrecise(bigNum *big.Int, precise uint64) string {
@@ -160,3 +306,163 @@ func ToIntByPrecise(str string, precise uint64) *big.Int {
 
 	return result
 }
+
+// CleanRippleChain is the side chain cleaner of ripple, it deletes the batch infos of a quit chain
+// from the first to the last batch and then the pending batch and vault. Done tx markers, tx infos and
+// payment records are not archived or deleted: they are keyed by the source chain and tx hash of each
+// transfer rather than by the quit chain, so they can not be walked in bounded batches, and purging them
+// would let deposits and transfers the chain already processed be replayed once it registers again
+func CleanRippleChain(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	batch, err := GetRippleBatch(module, chainID)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("CleanRippleChain, GetRippleBatch error: %v", err)
+	}
+	used := 0
+	for ; cursor < batch.NextBatchID && used < limit; cursor++ {
+		module.GetCacheDB().Delete(utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH_INFO),
+			utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(cursor)))
+		used++
+	}
+	if cursor < batch.NextBatchID {
+		return cursor, used, false, nil
+	}
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	module.GetCacheDB().Delete(utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_BATCH), chainIDBytes))
+	module.GetCacheDB().Delete(utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_VAULT), chainIDBytes))
+	return cursor, used + 2, true, nil
+}
+
+// IsRippleChainDrained is the drain check of ripple, a chain is drained once no transfer waits in its
+// batch and no payment is waiting for its ledger result
+func IsRippleChainDrained(module *contract.ModuleContract, chainID uint64) (bool, error) {
+	batch, err := GetRippleBatch(module, chainID)
+	if err != nil {
+		return false, fmt.Errorf("IsRippleChainDrained, GetRippleBatch error: %v", err)
+	}
+	vault, err := GetRippleVault(module, chainID)
+	if err != nil {
+		return false, fmt.Errorf("IsRippleChainDrained, GetRippleVault error: %v", err)
+	}
+	return len(batch.Transfers) == 0 && vault.Pending.Sign() == 0, nil
+}
+
+func PutRippleVault(module *contract.ModuleContract, chainId uint64, vault *RippleVault) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_VAULT), utils.GetUint64Bytes(chainId))
+	blob, err := rlp.EncodeToBytes(vault)
+	if err != nil {
+		return fmt.Errorf("PutRippleVault, rlp.EncodeToBytes vault error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+func GetRippleVault(module *contract.ModuleContract, chainId uint64) (*RippleVault, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_VAULT), utils.GetUint64Bytes(chainId))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleVault, get vault store error: %v", err)
+	}
+	vault := &RippleVault{
+		Deposited: new(big.Int),
+		Withdrawn: new(big.Int),
+		Fees:      new(big.Int),
+		Pending:   new(big.Int),
+	}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, vault); err != nil {
+			return nil, fmt.Errorf("GetRippleVault, deserialize vault error: %v", err)
+		}
+	}
+	return vault, nil
+}
+
+func PutRipplePayment(module *contract.ModuleContract, raw string, payment *RipplePayment) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_PAYMENT), []byte(raw))
+	blob, err := rlp.EncodeToBytes(payment)
+	if err != nil {
+		return fmt.Errorf("PutRipplePayment, rlp.EncodeToBytes payment error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+// GetRipplePayment returns nil for payments made before transfers were recorded
+func GetRipplePayment(module *contract.ModuleContract, raw string) (*RipplePayment, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_PAYMENT), []byte(raw))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRipplePayment, get payment store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	payment := new(RipplePayment)
+	if err := rlp.DecodeBytes(store, payment); err != nil {
+		return nil, fmt.Errorf("GetRipplePayment, deserialize payment error: %v", err)
+	}
+	return payment, nil
+}
+
+func PutRippleTxResult(module *contract.ModuleContract, raw string, result *RippleTxResult) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_TX_RESULT), []byte(raw))
+	blob, err := rlp.EncodeToBytes(result)
+	if err != nil {
+		return fmt.Errorf("PutRippleTxResult, rlp.EncodeToBytes result error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+// GetRippleTxResult returns nil if the result of the payment is not confirmed yet
+func GetRippleTxResult(module *contract.ModuleContract, raw string) (*RippleTxResult, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_TX_RESULT), []byte(raw))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleTxResult, get result store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	result := new(RippleTxResult)
+	if err := rlp.DecodeBytes(store, result); err != nil {
+		return nil, fmt.Errorf("GetRippleTxResult, deserialize result error: %v", err)
+	}
+	return result, nil
+}
+
+// PutRippleTxParam keeps the request of a transfer paid out on a ripple chain, so a refused payment
+// can be refunded to its sender
+func PutRippleTxParam(module *contract.ModuleContract, fromChainId uint64, param *common.MakeTxParam) error {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_TX_PARAM),
+		utils.GetUint64Bytes(fromChainId), param.TxHash)
+	blob, err := rlp.EncodeToBytes(param)
+	if err != nil {
+		return fmt.Errorf("PutRippleTxParam, rlp.EncodeToBytes param error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+// GetRippleTxParam returns nil if the request of the transfer is not recorded
+func GetRippleTxParam(module *contract.ModuleContract, fromChainId uint64, txHash []byte) (*common.MakeTxParam, error) {
+	key := utils.ConcatKey(cfg.CrossChainManagerContractAddress, []byte(common.RIPPLE_TX_PARAM),
+		utils.GetUint64Bytes(fromChainId), txHash)
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("GetRippleTxParam, get param store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	param := new(common.MakeTxParam)
+	if err := rlp.DecodeBytes(store, param); err != nil {
+		return nil, fmt.Errorf("GetRippleTxParam, deserialize param error: %v", err)
+	}
+	return param, nil
+}
+
+// putPayment binds every transfer of a payment to its raw tx
+func putPayment(module *contract.ModuleContract, raw string, payment *RipplePayment) error {
+	for i, fromChainId := range payment.FromChainIDs {
+		if err := PutTxJsonInfo(module, fromChainId, payment.TxHashes[i], raw); err != nil {
+			return fmt.Errorf("putPayment, PutTxJsonInfo error: %v", err)
+		}
+	}
+	return PutRipplePayment(module, raw, payment)
+}
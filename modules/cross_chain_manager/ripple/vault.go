@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2021 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ripple
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+)
+
+// commit reserves amount for an outbound payment, a negative amount releases part of an earlier
+// commitment. A nil reserve skips the solvency check.
+func (this *RippleVault) commit(amount, reserve *big.Int) error {
+	pending := new(big.Int).Add(this.Pending, amount)
+	if pending.Sign() < 0 {
+		return fmt.Errorf("pending amount can not be negative")
+	}
+	if reserve != nil && amount.Sign() > 0 {
+		available := new(big.Int).Sub(this.Balance(), pending)
+		if available.Cmp(reserve) < 0 {
+			return fmt.Errorf("vault balance %s would fall below reserve %s, pending %s",
+				this.Balance().String(), reserve.String(), pending.String())
+		}
+	}
+	this.Pending = pending
+	return nil
+}
+
+// settle releases the commitment of a payment once its ledger result is known, delivered and
+// fee are what the ledger actually took from the multisign account
+func (this *RippleVault) settle(committed, delivered, fee *big.Int) error {
+	if committed.Cmp(this.Pending) > 0 {
+		return fmt.Errorf("committed amount %s exceeds pending %s", committed.String(), this.Pending.String())
+	}
+	this.Pending = new(big.Int).Sub(this.Pending, committed)
+	this.Withdrawn = new(big.Int).Add(this.Withdrawn, delivered)
+	this.Fees = new(big.Int).Add(this.Fees, fee)
+	return nil
+}
+
+// reconcile moves the tracked balance to what the multisign account really holds, the difference
+// is booked as a deposit so withdrawn and fees keep their history
+func (this *RippleVault) reconcile(balance *big.Int) {
+	this.Deposited = new(big.Int).Add(balance, this.Withdrawn)
+	this.Deposited.Add(this.Deposited, this.Fees)
+}
+
+// IsSolvent reports whether the vault still covers its reserve after all pending payments
+func (this *RippleVault) IsSolvent(reserve *big.Int) bool {
+	if reserve == nil {
+		return this.Available().Sign() >= 0
+	}
+	return this.Available().Cmp(reserve) >= 0
+}
+
+func recordDeposit(service *contract.ModuleContract, chainId uint64, amount *big.Int) error {
+	vault, err := GetRippleVault(service, chainId)
+	if err != nil {
+		return fmt.Errorf("recordDeposit, GetRippleVault error: %v", err)
+	}
+	vault.Deposited = new(big.Int).Add(vault.Deposited, amount)
+	if err := PutRippleVault(service, chainId, vault); err != nil {
+		return fmt.Errorf("recordDeposit, PutRippleVault error: %v", err)
+	}
+	return nil
+}
+
+func commitPayment(service *contract.ModuleContract, rippleExtraInfo *side_chain_manager.RippleExtraInfo,
+	chainId uint64, amount *big.Int) error {
+	vault, err := GetRippleVault(service, chainId)
+	if err != nil {
+		return fmt.Errorf("commitPayment, GetRippleVault error: %v", err)
+	}
+	if err := vault.commit(amount, rippleExtraInfo.VaultReserve); err != nil {
+		return fmt.Errorf("commitPayment, vault of chain %d: %v", chainId, err)
+	}
+	if err := PutRippleVault(service, chainId, vault); err != nil {
+		return fmt.Errorf("commitPayment, PutRippleVault error: %v", err)
+	}
+	return nil
+}
+
+func settlePayment(service *contract.ModuleContract, chainId uint64, committed, delivered, fee *big.Int) error {
+	vault, err := GetRippleVault(service, chainId)
+	if err != nil {
+		return fmt.Errorf("settlePayment, GetRippleVault error: %v", err)
+	}
+	if err := vault.settle(committed, delivered, fee); err != nil {
+		return fmt.Errorf("settlePayment, vault of chain %d: %v", chainId, err)
+	}
+	if err := PutRippleVault(service, chainId, vault); err != nil {
+		return fmt.Errorf("settlePayment, PutRippleVault error: %v", err)
+	}
+	return nil
+}
+
+// ReconcileVault seeds or corrects the vault of a ripple chain once a quorum of signers reported the
+// balance of its multisign account. Payments in flight are still pending in the vault, so the
+// reported balance has to include what they will take from the account.
+func (this *RippleHandler) ReconcileVault(service *contract.ModuleContract) error {
+	ctx := service.ContractRef().CurrentContext()
+	params := &common.ReconcileRippleVaultParam{}
+	if err := contract.UnpackMethod(common.ABI, common.MethodReconcileRippleVault, params, ctx.Payload); err != nil {
+		return fmt.Errorf("ReconcileVault, contract params deserialize error: %v", err)
+	}
+	if _, err := side_chain_manager.GetRippleExtraInfo(service, params.ChainId); err != nil {
+		return fmt.Errorf("ReconcileVault, side_chain_manager.GetRippleExtraInfo error: %v", err)
+	}
+	vault, err := GetRippleVault(service, params.ChainId)
+	if err != nil {
+		return fmt.Errorf("ReconcileVault, GetRippleVault error: %v", err)
+	}
+
+	// votes only add up against the same tracked balance, a vote cast before the vault moved is stale
+	id := append(utils.GetUint64Bytes(params.ChainId), utils.GetUint64Bytes(params.Balance)...)
+	id = append(id, []byte(vault.Balance().String())...)
+	ok, err := node_manager.CheckConsensusSigns(service, common.MethodReconcileRippleVault, id,
+		service.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return fmt.Errorf("ReconcileVault, node_manager.CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	vault.reconcile(new(big.Int).SetUint64(params.Balance))
+	if err := PutRippleVault(service, params.ChainId, vault); err != nil {
+		return fmt.Errorf("ReconcileVault, PutRippleVault error: %v", err)
+	}
+	return nil
+}
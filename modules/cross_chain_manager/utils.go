@@ -39,3 +39,9 @@ func blackChainKey(chainID uint64) []byte {
 	contractAddr := cfg.CrossChainManagerContractAddress
 	return utils.ConcatKey(contractAddr, []byte(BLACKED_CHAIN), utils.GetUint64Bytes(chainID))
 }
+
+// cleanBlackChain is the side chain cleaner of cross chain manager, a quit chain registered again starts unblocked
+func cleanBlackChain(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	module.GetCacheDB().Delete(blackChainKey(chainID))
+	return cursor, 1, true, nil
+}
@@ -31,38 +31,65 @@ var (
 
 	MethodWhiteChain = "WhiteChain"
 
+	MethodConfirmRippleTx = "confirmRippleTx"
+
+	MethodFlushRippleBatch = "flushRippleBatch"
+
 	MethodImportOuterTransfer = "importOuterTransfer"
 
+	MethodImportOuterTransfer64 = "importOuterTransfer64"
+
 	MethodMultiSignRipple = "multiSignRipple"
 
+	MethodMultiSignRippleBatch = "multiSignRippleBatch"
+
+	MethodReconcileRippleVault = "reconcileRippleVault"
+
 	MethodReconstructRippleTx = "reconstructRippleTx"
 
 	MethodReplenish = "replenish"
 
 	MethodCheckDone = "checkDone"
 
+	MethodGetRippleTxStatus = "getRippleTxStatus"
+
+	MethodGetRippleVault = "getRippleVault"
+
 	MethodName = "name"
 
 	EventMultiSign = "MultiSign"
 
 	EventReplenishEvent = "ReplenishEvent"
 
+	EventRippleBatch = "RippleBatch"
+
 	EventRippleTx = "RippleTx"
 
+	EventRippleTxQueued = "RippleTxQueued"
+
+	EventRippleTxResult = "RippleTxResult"
+
 	EventMakeProof = "makeProof"
 )
 
 // ICrossChainManagerABI is the input ABI used to generate the binding from.
-const ICrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"payment\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"MultiSign\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txJson\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"RippleTx\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"checkDone\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AssetAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"TxJson\",\"type\":\"string\"}],\"name\":\"multiSignRipple\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"reconstructRippleTx\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const ICrossChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"payment\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"MultiSign\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"batchId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"txJsons\",\"type\":\"string[]\"}],\"name\":\"RippleBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txJson\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"sequence\",\"type\":\"uint32\"}],\"name\":\"RippleTx\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"}],\"name\":\"RippleTxQueued\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"fromChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"toChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"txHash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"result\",\"type\":\"string\"}],\"name\":\"RippleTxResult\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"merkleValueHex\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"BlockHeight\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"makeProof\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"BlackChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainID\",\"type\":\"uint64\"}],\"name\":\"WhiteChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"crossChainID\",\"type\":\"bytes\"}],\"name\":\"checkDone\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"Result\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"Delivered\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Fee\",\"type\":\"uint64\"}],\"name\":\"confirmRippleTx\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"flushRippleBatch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"}],\"name\":\"getRippleTxStatus\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"getRippleVault\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"balance\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"pending\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deposited\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"withdrawn\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"fees\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"solvent\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"Height\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"SourceChainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Height\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"Proof\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Extra\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"}],\"name\":\"importOuterTransfer64\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"AssetAddress\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"TxJson\",\"type\":\"string\"}],\"name\":\"multiSignRipple\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"BatchId\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"TxJsons\",\"type\":\"string[]\"}],\"name\":\"multiSignRippleBatch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"Balance\",\"type\":\"uint64\"}],\"name\":\"reconcileRippleVault\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"FromChainId\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"TxHash\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"ToChainId\",\"type\":\"uint64\"}],\"name\":\"reconstructRippleTx\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"txHashes\",\"type\":\"string[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // ICrossChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var ICrossChainManagerFuncSigs = map[string]string{
 	"8a449f03": "BlackChain(uint64)",
 	"99d0e87a": "WhiteChain(uint64)",
 	"1245f8d5": "checkDone(uint64,bytes)",
+	"ce89b342": "confirmRippleTx(uint64,uint64,bytes,string,uint64,uint64)",
+	"77db492a": "flushRippleBatch(uint64)",
+	"616f3e8d": "getRippleTxStatus(uint64,uint64,bytes)",
+	"ddb48178": "getRippleVault(uint64)",
 	"bbc2a76a": "importOuterTransfer(uint64,uint32,bytes,bytes,bytes)",
+	"6bfbc929": "importOuterTransfer64(uint64,uint64,bytes,bytes,bytes)",
 	"b7ef3989": "multiSignRipple(uint64,bytes,uint64,bytes,string)",
+	"527a8c0c": "multiSignRippleBatch(uint64,uint64,string[])",
 	"06fdde03": "name()",
+	"59d27933": "reconcileRippleVault(uint64,uint64)",
 	"3b178819": "reconstructRippleTx(uint64,bytes,uint64)",
 	"f8bac498": "replenish(uint64,string[])",
 }
@@ -240,6 +267,102 @@ func (_ICrossChainManager *ICrossChainManagerCallerSession) CheckDone(chainID ui
 	return _ICrossChainManager.Contract.CheckDone(&_ICrossChainManager.CallOpts, chainID, crossChainID)
 }
 
+// GetRippleTxStatus is a free data retrieval call binding the contract method 0x616f3e8d.
+//
+// Solidity: function getRippleTxStatus(uint64 FromChainId, uint64 ToChainId, bytes TxHash) view returns(uint8 status)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetRippleTxStatus(opts *bind.CallOpts, FromChainId uint64, ToChainId uint64, TxHash []byte) (uint8, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getRippleTxStatus", FromChainId, ToChainId, TxHash)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GetRippleTxStatus is a free data retrieval call binding the contract method 0x616f3e8d.
+//
+// Solidity: function getRippleTxStatus(uint64 FromChainId, uint64 ToChainId, bytes TxHash) view returns(uint8 status)
+func (_ICrossChainManager *ICrossChainManagerSession) GetRippleTxStatus(FromChainId uint64, ToChainId uint64, TxHash []byte) (uint8, error) {
+	return _ICrossChainManager.Contract.GetRippleTxStatus(&_ICrossChainManager.CallOpts, FromChainId, ToChainId, TxHash)
+}
+
+// GetRippleTxStatus is a free data retrieval call binding the contract method 0x616f3e8d.
+//
+// Solidity: function getRippleTxStatus(uint64 FromChainId, uint64 ToChainId, bytes TxHash) view returns(uint8 status)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetRippleTxStatus(FromChainId uint64, ToChainId uint64, TxHash []byte) (uint8, error) {
+	return _ICrossChainManager.Contract.GetRippleTxStatus(&_ICrossChainManager.CallOpts, FromChainId, ToChainId, TxHash)
+}
+
+// GetRippleVault is a free data retrieval call binding the contract method 0xddb48178.
+//
+// Solidity: function getRippleVault(uint64 ChainId) view returns(int256 balance, uint256 pending, uint256 deposited, uint256 withdrawn, uint256 fees, bool solvent)
+func (_ICrossChainManager *ICrossChainManagerCaller) GetRippleVault(opts *bind.CallOpts, ChainId uint64) (struct {
+	Balance   *big.Int
+	Pending   *big.Int
+	Deposited *big.Int
+	Withdrawn *big.Int
+	Fees      *big.Int
+	Solvent   bool
+}, error) {
+	var out []interface{}
+	err := _ICrossChainManager.contract.Call(opts, &out, "getRippleVault", ChainId)
+
+	outstruct := new(struct {
+		Balance   *big.Int
+		Pending   *big.Int
+		Deposited *big.Int
+		Withdrawn *big.Int
+		Fees      *big.Int
+		Solvent   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Balance = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Pending = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Deposited = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Withdrawn = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Fees = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Solvent = *abi.ConvertType(out[5], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetRippleVault is a free data retrieval call binding the contract method 0xddb48178.
+//
+// Solidity: function getRippleVault(uint64 ChainId) view returns(int256 balance, uint256 pending, uint256 deposited, uint256 withdrawn, uint256 fees, bool solvent)
+func (_ICrossChainManager *ICrossChainManagerSession) GetRippleVault(ChainId uint64) (struct {
+	Balance   *big.Int
+	Pending   *big.Int
+	Deposited *big.Int
+	Withdrawn *big.Int
+	Fees      *big.Int
+	Solvent   bool
+}, error) {
+	return _ICrossChainManager.Contract.GetRippleVault(&_ICrossChainManager.CallOpts, ChainId)
+}
+
+// GetRippleVault is a free data retrieval call binding the contract method 0xddb48178.
+//
+// Solidity: function getRippleVault(uint64 ChainId) view returns(int256 balance, uint256 pending, uint256 deposited, uint256 withdrawn, uint256 fees, bool solvent)
+func (_ICrossChainManager *ICrossChainManagerCallerSession) GetRippleVault(ChainId uint64) (struct {
+	Balance   *big.Int
+	Pending   *big.Int
+	Deposited *big.Int
+	Withdrawn *big.Int
+	Fees      *big.Int
+	Solvent   bool
+}, error) {
+	return _ICrossChainManager.Contract.GetRippleVault(&_ICrossChainManager.CallOpts, ChainId)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string Name)
@@ -313,6 +436,48 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) WhiteChain(Chain
 	return _ICrossChainManager.Contract.WhiteChain(&_ICrossChainManager.TransactOpts, ChainID)
 }
 
+// ConfirmRippleTx is a paid mutator transaction binding the contract method 0xce89b342.
+//
+// Solidity: function confirmRippleTx(uint64 FromChainId, uint64 ToChainId, bytes TxHash, string Result, uint64 Delivered, uint64 Fee) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) ConfirmRippleTx(opts *bind.TransactOpts, FromChainId uint64, ToChainId uint64, TxHash []byte, Result string, Delivered uint64, Fee uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "confirmRippleTx", FromChainId, ToChainId, TxHash, Result, Delivered, Fee)
+}
+
+// ConfirmRippleTx is a paid mutator transaction binding the contract method 0xce89b342.
+//
+// Solidity: function confirmRippleTx(uint64 FromChainId, uint64 ToChainId, bytes TxHash, string Result, uint64 Delivered, uint64 Fee) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) ConfirmRippleTx(FromChainId uint64, ToChainId uint64, TxHash []byte, Result string, Delivered uint64, Fee uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ConfirmRippleTx(&_ICrossChainManager.TransactOpts, FromChainId, ToChainId, TxHash, Result, Delivered, Fee)
+}
+
+// ConfirmRippleTx is a paid mutator transaction binding the contract method 0xce89b342.
+//
+// Solidity: function confirmRippleTx(uint64 FromChainId, uint64 ToChainId, bytes TxHash, string Result, uint64 Delivered, uint64 Fee) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) ConfirmRippleTx(FromChainId uint64, ToChainId uint64, TxHash []byte, Result string, Delivered uint64, Fee uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ConfirmRippleTx(&_ICrossChainManager.TransactOpts, FromChainId, ToChainId, TxHash, Result, Delivered, Fee)
+}
+
+// FlushRippleBatch is a paid mutator transaction binding the contract method 0x77db492a.
+//
+// Solidity: function flushRippleBatch(uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) FlushRippleBatch(opts *bind.TransactOpts, ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "flushRippleBatch", ToChainId)
+}
+
+// FlushRippleBatch is a paid mutator transaction binding the contract method 0x77db492a.
+//
+// Solidity: function flushRippleBatch(uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) FlushRippleBatch(ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.FlushRippleBatch(&_ICrossChainManager.TransactOpts, ToChainId)
+}
+
+// FlushRippleBatch is a paid mutator transaction binding the contract method 0x77db492a.
+//
+// Solidity: function flushRippleBatch(uint64 ToChainId) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) FlushRippleBatch(ToChainId uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.FlushRippleBatch(&_ICrossChainManager.TransactOpts, ToChainId)
+}
+
 // ImportOuterTransfer is a paid mutator transaction binding the contract method 0xbbc2a76a.
 //
 // Solidity: function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
@@ -334,6 +499,27 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) ImportOuterTrans
 	return _ICrossChainManager.Contract.ImportOuterTransfer(&_ICrossChainManager.TransactOpts, SourceChainID, Height, Proof, Extra, Signature)
 }
 
+// ImportOuterTransfer64 is a paid mutator transaction binding the contract method 0x6bfbc929.
+//
+// Solidity: function importOuterTransfer64(uint64 SourceChainID, uint64 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) ImportOuterTransfer64(opts *bind.TransactOpts, SourceChainID uint64, Height uint64, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "importOuterTransfer64", SourceChainID, Height, Proof, Extra, Signature)
+}
+
+// ImportOuterTransfer64 is a paid mutator transaction binding the contract method 0x6bfbc929.
+//
+// Solidity: function importOuterTransfer64(uint64 SourceChainID, uint64 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) ImportOuterTransfer64(SourceChainID uint64, Height uint64, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ImportOuterTransfer64(&_ICrossChainManager.TransactOpts, SourceChainID, Height, Proof, Extra, Signature)
+}
+
+// ImportOuterTransfer64 is a paid mutator transaction binding the contract method 0x6bfbc929.
+//
+// Solidity: function importOuterTransfer64(uint64 SourceChainID, uint64 Height, bytes Proof, bytes Extra, bytes Signature) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) ImportOuterTransfer64(SourceChainID uint64, Height uint64, Proof []byte, Extra []byte, Signature []byte) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ImportOuterTransfer64(&_ICrossChainManager.TransactOpts, SourceChainID, Height, Proof, Extra, Signature)
+}
+
 // MultiSignRipple is a paid mutator transaction binding the contract method 0xb7ef3989.
 //
 // Solidity: function multiSignRipple(uint64 ToChainId, bytes AssetAddress, uint64 FromChainId, bytes TxHash, string TxJson) returns(bool success)
@@ -355,6 +541,48 @@ func (_ICrossChainManager *ICrossChainManagerTransactorSession) MultiSignRipple(
 	return _ICrossChainManager.Contract.MultiSignRipple(&_ICrossChainManager.TransactOpts, ToChainId, AssetAddress, FromChainId, TxHash, TxJson)
 }
 
+// MultiSignRippleBatch is a paid mutator transaction binding the contract method 0x527a8c0c.
+//
+// Solidity: function multiSignRippleBatch(uint64 ToChainId, uint64 BatchId, string[] TxJsons) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) MultiSignRippleBatch(opts *bind.TransactOpts, ToChainId uint64, BatchId uint64, TxJsons []string) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "multiSignRippleBatch", ToChainId, BatchId, TxJsons)
+}
+
+// MultiSignRippleBatch is a paid mutator transaction binding the contract method 0x527a8c0c.
+//
+// Solidity: function multiSignRippleBatch(uint64 ToChainId, uint64 BatchId, string[] TxJsons) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) MultiSignRippleBatch(ToChainId uint64, BatchId uint64, TxJsons []string) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.MultiSignRippleBatch(&_ICrossChainManager.TransactOpts, ToChainId, BatchId, TxJsons)
+}
+
+// MultiSignRippleBatch is a paid mutator transaction binding the contract method 0x527a8c0c.
+//
+// Solidity: function multiSignRippleBatch(uint64 ToChainId, uint64 BatchId, string[] TxJsons) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) MultiSignRippleBatch(ToChainId uint64, BatchId uint64, TxJsons []string) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.MultiSignRippleBatch(&_ICrossChainManager.TransactOpts, ToChainId, BatchId, TxJsons)
+}
+
+// ReconcileRippleVault is a paid mutator transaction binding the contract method 0x59d27933.
+//
+// Solidity: function reconcileRippleVault(uint64 ChainId, uint64 Balance) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactor) ReconcileRippleVault(opts *bind.TransactOpts, ChainId uint64, Balance uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.contract.Transact(opts, "reconcileRippleVault", ChainId, Balance)
+}
+
+// ReconcileRippleVault is a paid mutator transaction binding the contract method 0x59d27933.
+//
+// Solidity: function reconcileRippleVault(uint64 ChainId, uint64 Balance) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerSession) ReconcileRippleVault(ChainId uint64, Balance uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ReconcileRippleVault(&_ICrossChainManager.TransactOpts, ChainId, Balance)
+}
+
+// ReconcileRippleVault is a paid mutator transaction binding the contract method 0x59d27933.
+//
+// Solidity: function reconcileRippleVault(uint64 ChainId, uint64 Balance) returns(bool success)
+func (_ICrossChainManager *ICrossChainManagerTransactorSession) ReconcileRippleVault(ChainId uint64, Balance uint64) (*types.Transaction, error) {
+	return _ICrossChainManager.Contract.ReconcileRippleVault(&_ICrossChainManager.TransactOpts, ChainId, Balance)
+}
+
 // ReconstructRippleTx is a paid mutator transaction binding the contract method 0x3b178819.
 //
 // Solidity: function reconstructRippleTx(uint64 FromChainId, bytes TxHash, uint64 ToChainId) returns(bool success)
@@ -670,6 +898,142 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) ParseReplenishEvent(log t
 	return event, nil
 }
 
+// ICrossChainManagerRippleBatchIterator is returned from FilterRippleBatch and is used to iterate over the raw logs and unpacked data for RippleBatch events raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleBatchIterator struct {
+	Event *ICrossChainManagerRippleBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerRippleBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerRippleBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerRippleBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerRippleBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerRippleBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerRippleBatch represents a RippleBatch event raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleBatch struct {
+	ToChainId uint64
+	BatchId   uint64
+	TxJsons   []string
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRippleBatch is a free log retrieval operation binding the contract event 0xd6300156f9b850beccdca0f9f9e3905e5de8b82aa4b4fc0fc64af3ad05bbda5f.
+//
+// Solidity: event RippleBatch(uint64 toChainId, uint64 batchId, string[] txJsons)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleBatch(opts *bind.FilterOpts) (*ICrossChainManagerRippleBatchIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleBatch")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerRippleBatchIterator{contract: _ICrossChainManager.contract, event: "RippleBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchRippleBatch is a free log subscription operation binding the contract event 0xd6300156f9b850beccdca0f9f9e3905e5de8b82aa4b4fc0fc64af3ad05bbda5f.
+//
+// Solidity: event RippleBatch(uint64 toChainId, uint64 batchId, string[] txJsons)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleBatch(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleBatch) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleBatch")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerRippleBatch)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRippleBatch is a log parse operation binding the contract event 0xd6300156f9b850beccdca0f9f9e3905e5de8b82aa4b4fc0fc64af3ad05bbda5f.
+//
+// Solidity: event RippleBatch(uint64 toChainId, uint64 batchId, string[] txJsons)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleBatch(log types.Log) (*ICrossChainManagerRippleBatch, error) {
+	event := new(ICrossChainManagerRippleBatch)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ICrossChainManagerRippleTxIterator is returned from FilterRippleTx and is used to iterate over the raw logs and unpacked data for RippleTx events raised by the ICrossChainManager contract.
 type ICrossChainManagerRippleTxIterator struct {
 	Event *ICrossChainManagerRippleTx // Event containing the contract specifics and raw log
@@ -808,6 +1172,279 @@ func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleTx(log types.L
 	return event, nil
 }
 
+// ICrossChainManagerRippleTxQueuedIterator is returned from FilterRippleTxQueued and is used to iterate over the raw logs and unpacked data for RippleTxQueued events raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTxQueuedIterator struct {
+	Event *ICrossChainManagerRippleTxQueued // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerRippleTxQueuedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerRippleTxQueued)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerRippleTxQueued)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerRippleTxQueuedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerRippleTxQueuedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerRippleTxQueued represents a RippleTxQueued event raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTxQueued struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterRippleTxQueued is a free log retrieval operation binding the contract event 0x806e3f9ee9edc45f2e4399951d4b62fd4c1cd3c911f2fac295896d1f9b4fd260.
+//
+// Solidity: event RippleTxQueued(uint64 fromChainId, uint64 toChainId, string txHash)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleTxQueued(opts *bind.FilterOpts) (*ICrossChainManagerRippleTxQueuedIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleTxQueued")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerRippleTxQueuedIterator{contract: _ICrossChainManager.contract, event: "RippleTxQueued", logs: logs, sub: sub}, nil
+}
+
+// WatchRippleTxQueued is a free log subscription operation binding the contract event 0x806e3f9ee9edc45f2e4399951d4b62fd4c1cd3c911f2fac295896d1f9b4fd260.
+//
+// Solidity: event RippleTxQueued(uint64 fromChainId, uint64 toChainId, string txHash)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTxQueued(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleTxQueued) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleTxQueued")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerRippleTxQueued)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTxQueued", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRippleTxQueued is a log parse operation binding the contract event 0x806e3f9ee9edc45f2e4399951d4b62fd4c1cd3c911f2fac295896d1f9b4fd260.
+//
+// Solidity: event RippleTxQueued(uint64 fromChainId, uint64 toChainId, string txHash)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleTxQueued(log types.Log) (*ICrossChainManagerRippleTxQueued, error) {
+	event := new(ICrossChainManagerRippleTxQueued)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTxQueued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ICrossChainManagerRippleTxResultIterator is returned from FilterRippleTxResult and is used to iterate over the raw logs and unpacked data for RippleTxResult events raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTxResultIterator struct {
+	Event *ICrossChainManagerRippleTxResult // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ICrossChainManagerRippleTxResultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ICrossChainManagerRippleTxResult)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ICrossChainManagerRippleTxResult)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ICrossChainManagerRippleTxResultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ICrossChainManagerRippleTxResultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ICrossChainManagerRippleTxResult represents a RippleTxResult event raised by the ICrossChainManager contract.
+type ICrossChainManagerRippleTxResult struct {
+	FromChainId uint64
+	ToChainId   uint64
+	TxHash      string
+	Result      string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterRippleTxResult is a free log retrieval operation binding the contract event 0x990303273000b2de3f37aad8f8698c3a02ef4fa95a9e256a95f82f041db6bda8.
+//
+// Solidity: event RippleTxResult(uint64 fromChainId, uint64 toChainId, string txHash, string result)
+func (_ICrossChainManager *ICrossChainManagerFilterer) FilterRippleTxResult(opts *bind.FilterOpts) (*ICrossChainManagerRippleTxResultIterator, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.FilterLogs(opts, "RippleTxResult")
+	if err != nil {
+		return nil, err
+	}
+	return &ICrossChainManagerRippleTxResultIterator{contract: _ICrossChainManager.contract, event: "RippleTxResult", logs: logs, sub: sub}, nil
+}
+
+// WatchRippleTxResult is a free log subscription operation binding the contract event 0x990303273000b2de3f37aad8f8698c3a02ef4fa95a9e256a95f82f041db6bda8.
+//
+// Solidity: event RippleTxResult(uint64 fromChainId, uint64 toChainId, string txHash, string result)
+func (_ICrossChainManager *ICrossChainManagerFilterer) WatchRippleTxResult(opts *bind.WatchOpts, sink chan<- *ICrossChainManagerRippleTxResult) (event.Subscription, error) {
+
+	logs, sub, err := _ICrossChainManager.contract.WatchLogs(opts, "RippleTxResult")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ICrossChainManagerRippleTxResult)
+				if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTxResult", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRippleTxResult is a log parse operation binding the contract event 0x990303273000b2de3f37aad8f8698c3a02ef4fa95a9e256a95f82f041db6bda8.
+//
+// Solidity: event RippleTxResult(uint64 fromChainId, uint64 toChainId, string txHash, string result)
+func (_ICrossChainManager *ICrossChainManagerFilterer) ParseRippleTxResult(log types.Log) (*ICrossChainManagerRippleTxResult, error) {
+	event := new(ICrossChainManagerRippleTxResult)
+	if err := _ICrossChainManager.contract.UnpackLog(event, "RippleTxResult", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ICrossChainManagerMakeProofIterator is returned from FilterMakeProof and is used to iterate over the raw logs and unpacked data for MakeProof events raised by the ICrossChainManager contract.
 type ICrossChainManagerMakeProofIterator struct {
 	Event *ICrossChainManagerMakeProof // Event containing the contract specifics and raw log
@@ -27,31 +27,82 @@ var (
 )
 
 var (
+	MethodPinRootInfo = "pinRootInfo"
+
 	MethodReplenish = "replenish"
 
+	MethodReplenish64 = "replenish64"
+
+	MethodSetRetention = "setRetention"
+
 	MethodSyncRootInfo = "syncRootInfo"
 
+	MethodSyncRootInfoAggregated = "syncRootInfoAggregated"
+
+	MethodGetHeaderFields = "getHeaderFields"
+
 	MethodGetInfo = "getInfo"
 
+	MethodGetInfo64 = "getInfo64"
+
 	MethodGetInfoHeight = "getInfoHeight"
 
+	MethodGetInfoHeight64 = "getInfoHeight64"
+
+	MethodGetInfoRange = "getInfoRange"
+
+	MethodGetMissingHeights = "getMissingHeights"
+
+	MethodGetReceiptsRoot = "getReceiptsRoot"
+
+	MethodGetRetention = "getRetention"
+
+	MethodGetStateRoot = "getStateRoot"
+
+	MethodGetSyncProgress = "getSyncProgress"
+
 	MethodName = "name"
 
+	EventReplenish64Event = "Replenish64Event"
+
 	EventReplenishEvent = "ReplenishEvent"
 
+	EventRetentionUpdated = "RetentionUpdated"
+
+	EventRootInfoConflict = "RootInfoConflict"
+
+	EventRootInfoPinned = "RootInfoPinned"
+
+	EventRootInfoPruned = "RootInfoPruned"
+
+	EventSyncRootInfo64Event = "SyncRootInfo64Event"
+
 	EventSyncRootInfoEvent = "SyncRootInfoEvent"
 )
 
 // IInfoSyncABI is the input ABI used to generate the binding from.
-const IInfoSyncABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint32[]\",\"name\":\"heights\",\"type\":\"uint32[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"height\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"SyncRootInfoEvent\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"height\",\"type\":\"uint32\"}],\"name\":\"getInfo\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getInfoHeight\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32[]\",\"name\":\"heights\",\"type\":\"uint32[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"rootInfos\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"syncRootInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const IInfoSyncABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64[]\",\"name\":\"heights\",\"type\":\"uint64[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"Replenish64Event\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint32[]\",\"name\":\"heights\",\"type\":\"uint32[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"ReplenishEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"}],\"name\":\"RetentionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"infoHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"conflictHash\",\"type\":\"bytes32\"}],\"name\":\"RootInfoConflict\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"pinned\",\"type\":\"bool\"}],\"name\":\"RootInfoPinned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"prunedHeight\",\"type\":\"uint64\"}],\"name\":\"RootInfoPruned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"SyncRootInfo64Event\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"height\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"BlockHeight\",\"type\":\"uint256\"}],\"name\":\"SyncRootInfoEvent\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getHeaderFields\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"stateRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"receiptsRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"transactionsRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"timestamp\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"height\",\"type\":\"uint32\"}],\"name\":\"getInfo\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getInfo64\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getInfoHeight\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getInfoHeight64\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"from\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"to\",\"type\":\"uint64\"}],\"name\":\"getInfoRange\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"heights\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"infos\",\"type\":\"bytes[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"from\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"to\",\"type\":\"uint64\"}],\"name\":\"getMissingHeights\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"heights\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getReceiptsRoot\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getRetention\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"prunedHeight\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getStateRoot\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"infoHash\",\"type\":\"bytes32\"}],\"name\":\"getSyncProgress\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"voters\",\"type\":\"address[]\"},{\"internalType\":\"uint64\",\"name\":\"quorum\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"pinned\",\"type\":\"bool\"}],\"name\":\"pinRootInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint32[]\",\"name\":\"heights\",\"type\":\"uint32[]\"}],\"name\":\"replenish\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"heights\",\"type\":\"uint64[]\"}],\"name\":\"replenish64\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"window\",\"type\":\"uint64\"}],\"name\":\"setRetention\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"rootInfos\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"syncRootInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes[]\",\"name\":\"rootInfos\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes[]\",\"name\":\"signatures\",\"type\":\"bytes[]\"}],\"name\":\"syncRootInfoAggregated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // IInfoSyncFuncSigs maps the 4-byte function signature to its string representation.
 var IInfoSyncFuncSigs = map[string]string{
+	"375d7c34": "getHeaderFields(uint64,uint64)",
 	"6a4a9f5e": "getInfo(uint64,uint32)",
+	"8c934411": "getInfo64(uint64,uint64)",
 	"16d80012": "getInfoHeight(uint64)",
+	"11fc662e": "getInfoHeight64(uint64)",
+	"7b82c15a": "getInfoRange(uint64,uint64,uint64)",
+	"8a73fdc4": "getMissingHeights(uint64,uint64,uint64)",
+	"8dbfcb25": "getReceiptsRoot(uint64,uint64)",
+	"00030384": "getRetention(uint64)",
+	"54148667": "getStateRoot(uint64,uint64)",
+	"1c621fcb": "getSyncProgress(uint64,uint64,bytes32)",
 	"06fdde03": "name()",
+	"39efbcee": "pinRootInfo(uint64,uint64,bool)",
 	"69ce93b4": "replenish(uint64,uint32[])",
+	"d7633c0d": "replenish64(uint64,uint64[])",
+	"c89b0a55": "setRetention(uint64,uint64)",
 	"1413cc01": "syncRootInfo(uint64,bytes[],bytes)",
+	"5e70b4c5": "syncRootInfoAggregated(uint64,bytes[],bytes[])",
 }
 
 // IInfoSync is an auto generated Go binding around an Ethereum contract.
@@ -196,6 +247,66 @@ func (_IInfoSync *IInfoSyncTransactorRaw) Transact(opts *bind.TransactOpts, meth
 	return _IInfoSync.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetHeaderFields is a free data retrieval call binding the contract method 0x375d7c34.
+//
+// Solidity: function getHeaderFields(uint64 chainID, uint64 height) view returns(bytes32 stateRoot, bytes32 receiptsRoot, bytes32 transactionsRoot, bytes32 blockHash, uint64 timestamp)
+func (_IInfoSync *IInfoSyncCaller) GetHeaderFields(opts *bind.CallOpts, chainID uint64, height uint64) (struct {
+	StateRoot        [32]byte
+	ReceiptsRoot     [32]byte
+	TransactionsRoot [32]byte
+	BlockHash        [32]byte
+	Timestamp        uint64
+}, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getHeaderFields", chainID, height)
+
+	outstruct := new(struct {
+		StateRoot        [32]byte
+		ReceiptsRoot     [32]byte
+		TransactionsRoot [32]byte
+		BlockHash        [32]byte
+		Timestamp        uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StateRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.ReceiptsRoot = *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	outstruct.TransactionsRoot = *abi.ConvertType(out[2], new([32]byte)).(*[32]byte)
+	outstruct.BlockHash = *abi.ConvertType(out[3], new([32]byte)).(*[32]byte)
+	outstruct.Timestamp = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetHeaderFields is a free data retrieval call binding the contract method 0x375d7c34.
+//
+// Solidity: function getHeaderFields(uint64 chainID, uint64 height) view returns(bytes32 stateRoot, bytes32 receiptsRoot, bytes32 transactionsRoot, bytes32 blockHash, uint64 timestamp)
+func (_IInfoSync *IInfoSyncSession) GetHeaderFields(chainID uint64, height uint64) (struct {
+	StateRoot        [32]byte
+	ReceiptsRoot     [32]byte
+	TransactionsRoot [32]byte
+	BlockHash        [32]byte
+	Timestamp        uint64
+}, error) {
+	return _IInfoSync.Contract.GetHeaderFields(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetHeaderFields is a free data retrieval call binding the contract method 0x375d7c34.
+//
+// Solidity: function getHeaderFields(uint64 chainID, uint64 height) view returns(bytes32 stateRoot, bytes32 receiptsRoot, bytes32 transactionsRoot, bytes32 blockHash, uint64 timestamp)
+func (_IInfoSync *IInfoSyncCallerSession) GetHeaderFields(chainID uint64, height uint64) (struct {
+	StateRoot        [32]byte
+	ReceiptsRoot     [32]byte
+	TransactionsRoot [32]byte
+	BlockHash        [32]byte
+	Timestamp        uint64
+}, error) {
+	return _IInfoSync.Contract.GetHeaderFields(&_IInfoSync.CallOpts, chainID, height)
+}
+
 // GetInfo is a free data retrieval call binding the contract method 0x6a4a9f5e.
 //
 // Solidity: function getInfo(uint64 chainID, uint32 height) view returns(bytes)
@@ -227,6 +338,37 @@ func (_IInfoSync *IInfoSyncCallerSession) GetInfo(chainID uint64, height uint32)
 	return _IInfoSync.Contract.GetInfo(&_IInfoSync.CallOpts, chainID, height)
 }
 
+// GetInfo64 is a free data retrieval call binding the contract method 0x8c934411.
+//
+// Solidity: function getInfo64(uint64 chainID, uint64 height) view returns(bytes)
+func (_IInfoSync *IInfoSyncCaller) GetInfo64(opts *bind.CallOpts, chainID uint64, height uint64) ([]byte, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getInfo64", chainID, height)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetInfo64 is a free data retrieval call binding the contract method 0x8c934411.
+//
+// Solidity: function getInfo64(uint64 chainID, uint64 height) view returns(bytes)
+func (_IInfoSync *IInfoSyncSession) GetInfo64(chainID uint64, height uint64) ([]byte, error) {
+	return _IInfoSync.Contract.GetInfo64(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetInfo64 is a free data retrieval call binding the contract method 0x8c934411.
+//
+// Solidity: function getInfo64(uint64 chainID, uint64 height) view returns(bytes)
+func (_IInfoSync *IInfoSyncCallerSession) GetInfo64(chainID uint64, height uint64) ([]byte, error) {
+	return _IInfoSync.Contract.GetInfo64(&_IInfoSync.CallOpts, chainID, height)
+}
+
 // GetInfoHeight is a free data retrieval call binding the contract method 0x16d80012.
 //
 // Solidity: function getInfoHeight(uint64 chainID) view returns(uint32)
@@ -258,6 +400,265 @@ func (_IInfoSync *IInfoSyncCallerSession) GetInfoHeight(chainID uint64) (uint32,
 	return _IInfoSync.Contract.GetInfoHeight(&_IInfoSync.CallOpts, chainID)
 }
 
+// GetInfoHeight64 is a free data retrieval call binding the contract method 0x11fc662e.
+//
+// Solidity: function getInfoHeight64(uint64 chainID) view returns(uint64)
+func (_IInfoSync *IInfoSyncCaller) GetInfoHeight64(opts *bind.CallOpts, chainID uint64) (uint64, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getInfoHeight64", chainID)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetInfoHeight64 is a free data retrieval call binding the contract method 0x11fc662e.
+//
+// Solidity: function getInfoHeight64(uint64 chainID) view returns(uint64)
+func (_IInfoSync *IInfoSyncSession) GetInfoHeight64(chainID uint64) (uint64, error) {
+	return _IInfoSync.Contract.GetInfoHeight64(&_IInfoSync.CallOpts, chainID)
+}
+
+// GetInfoHeight64 is a free data retrieval call binding the contract method 0x11fc662e.
+//
+// Solidity: function getInfoHeight64(uint64 chainID) view returns(uint64)
+func (_IInfoSync *IInfoSyncCallerSession) GetInfoHeight64(chainID uint64) (uint64, error) {
+	return _IInfoSync.Contract.GetInfoHeight64(&_IInfoSync.CallOpts, chainID)
+}
+
+// GetInfoRange is a free data retrieval call binding the contract method 0x7b82c15a.
+//
+// Solidity: function getInfoRange(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights, bytes[] infos)
+func (_IInfoSync *IInfoSyncCaller) GetInfoRange(opts *bind.CallOpts, chainID uint64, from uint64, to uint64) (struct {
+	Heights []uint64
+	Infos   [][]byte
+}, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getInfoRange", chainID, from, to)
+
+	outstruct := new(struct {
+		Heights []uint64
+		Infos   [][]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Heights = *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+	outstruct.Infos = *abi.ConvertType(out[1], new([][]byte)).(*[][]byte)
+
+	return *outstruct, err
+
+}
+
+// GetInfoRange is a free data retrieval call binding the contract method 0x7b82c15a.
+//
+// Solidity: function getInfoRange(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights, bytes[] infos)
+func (_IInfoSync *IInfoSyncSession) GetInfoRange(chainID uint64, from uint64, to uint64) (struct {
+	Heights []uint64
+	Infos   [][]byte
+}, error) {
+	return _IInfoSync.Contract.GetInfoRange(&_IInfoSync.CallOpts, chainID, from, to)
+}
+
+// GetInfoRange is a free data retrieval call binding the contract method 0x7b82c15a.
+//
+// Solidity: function getInfoRange(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights, bytes[] infos)
+func (_IInfoSync *IInfoSyncCallerSession) GetInfoRange(chainID uint64, from uint64, to uint64) (struct {
+	Heights []uint64
+	Infos   [][]byte
+}, error) {
+	return _IInfoSync.Contract.GetInfoRange(&_IInfoSync.CallOpts, chainID, from, to)
+}
+
+// GetMissingHeights is a free data retrieval call binding the contract method 0x8a73fdc4.
+//
+// Solidity: function getMissingHeights(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights)
+func (_IInfoSync *IInfoSyncCaller) GetMissingHeights(opts *bind.CallOpts, chainID uint64, from uint64, to uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getMissingHeights", chainID, from, to)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetMissingHeights is a free data retrieval call binding the contract method 0x8a73fdc4.
+//
+// Solidity: function getMissingHeights(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights)
+func (_IInfoSync *IInfoSyncSession) GetMissingHeights(chainID uint64, from uint64, to uint64) ([]uint64, error) {
+	return _IInfoSync.Contract.GetMissingHeights(&_IInfoSync.CallOpts, chainID, from, to)
+}
+
+// GetMissingHeights is a free data retrieval call binding the contract method 0x8a73fdc4.
+//
+// Solidity: function getMissingHeights(uint64 chainID, uint64 from, uint64 to) view returns(uint64[] heights)
+func (_IInfoSync *IInfoSyncCallerSession) GetMissingHeights(chainID uint64, from uint64, to uint64) ([]uint64, error) {
+	return _IInfoSync.Contract.GetMissingHeights(&_IInfoSync.CallOpts, chainID, from, to)
+}
+
+// GetReceiptsRoot is a free data retrieval call binding the contract method 0x8dbfcb25.
+//
+// Solidity: function getReceiptsRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncCaller) GetReceiptsRoot(opts *bind.CallOpts, chainID uint64, height uint64) ([32]byte, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getReceiptsRoot", chainID, height)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetReceiptsRoot is a free data retrieval call binding the contract method 0x8dbfcb25.
+//
+// Solidity: function getReceiptsRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncSession) GetReceiptsRoot(chainID uint64, height uint64) ([32]byte, error) {
+	return _IInfoSync.Contract.GetReceiptsRoot(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetReceiptsRoot is a free data retrieval call binding the contract method 0x8dbfcb25.
+//
+// Solidity: function getReceiptsRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncCallerSession) GetReceiptsRoot(chainID uint64, height uint64) ([32]byte, error) {
+	return _IInfoSync.Contract.GetReceiptsRoot(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetRetention is a free data retrieval call binding the contract method 0x00030384.
+//
+// Solidity: function getRetention(uint64 chainID) view returns(uint64 window, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncCaller) GetRetention(opts *bind.CallOpts, chainID uint64) (struct {
+	Window       uint64
+	PrunedHeight uint64
+}, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getRetention", chainID)
+
+	outstruct := new(struct {
+		Window       uint64
+		PrunedHeight uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Window = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.PrunedHeight = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetRetention is a free data retrieval call binding the contract method 0x00030384.
+//
+// Solidity: function getRetention(uint64 chainID) view returns(uint64 window, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncSession) GetRetention(chainID uint64) (struct {
+	Window       uint64
+	PrunedHeight uint64
+}, error) {
+	return _IInfoSync.Contract.GetRetention(&_IInfoSync.CallOpts, chainID)
+}
+
+// GetRetention is a free data retrieval call binding the contract method 0x00030384.
+//
+// Solidity: function getRetention(uint64 chainID) view returns(uint64 window, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncCallerSession) GetRetention(chainID uint64) (struct {
+	Window       uint64
+	PrunedHeight uint64
+}, error) {
+	return _IInfoSync.Contract.GetRetention(&_IInfoSync.CallOpts, chainID)
+}
+
+// GetStateRoot is a free data retrieval call binding the contract method 0x54148667.
+//
+// Solidity: function getStateRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncCaller) GetStateRoot(opts *bind.CallOpts, chainID uint64, height uint64) ([32]byte, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getStateRoot", chainID, height)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetStateRoot is a free data retrieval call binding the contract method 0x54148667.
+//
+// Solidity: function getStateRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncSession) GetStateRoot(chainID uint64, height uint64) ([32]byte, error) {
+	return _IInfoSync.Contract.GetStateRoot(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetStateRoot is a free data retrieval call binding the contract method 0x54148667.
+//
+// Solidity: function getStateRoot(uint64 chainID, uint64 height) view returns(bytes32)
+func (_IInfoSync *IInfoSyncCallerSession) GetStateRoot(chainID uint64, height uint64) ([32]byte, error) {
+	return _IInfoSync.Contract.GetStateRoot(&_IInfoSync.CallOpts, chainID, height)
+}
+
+// GetSyncProgress is a free data retrieval call binding the contract method 0x1c621fcb.
+//
+// Solidity: function getSyncProgress(uint64 chainID, uint64 height, bytes32 infoHash) view returns(address[] voters, uint64 quorum)
+func (_IInfoSync *IInfoSyncCaller) GetSyncProgress(opts *bind.CallOpts, chainID uint64, height uint64, infoHash [32]byte) (struct {
+	Voters []common.Address
+	Quorum uint64
+}, error) {
+	var out []interface{}
+	err := _IInfoSync.contract.Call(opts, &out, "getSyncProgress", chainID, height, infoHash)
+
+	outstruct := new(struct {
+		Voters []common.Address
+		Quorum uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Voters = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Quorum = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetSyncProgress is a free data retrieval call binding the contract method 0x1c621fcb.
+//
+// Solidity: function getSyncProgress(uint64 chainID, uint64 height, bytes32 infoHash) view returns(address[] voters, uint64 quorum)
+func (_IInfoSync *IInfoSyncSession) GetSyncProgress(chainID uint64, height uint64, infoHash [32]byte) (struct {
+	Voters []common.Address
+	Quorum uint64
+}, error) {
+	return _IInfoSync.Contract.GetSyncProgress(&_IInfoSync.CallOpts, chainID, height, infoHash)
+}
+
+// GetSyncProgress is a free data retrieval call binding the contract method 0x1c621fcb.
+//
+// Solidity: function getSyncProgress(uint64 chainID, uint64 height, bytes32 infoHash) view returns(address[] voters, uint64 quorum)
+func (_IInfoSync *IInfoSyncCallerSession) GetSyncProgress(chainID uint64, height uint64, infoHash [32]byte) (struct {
+	Voters []common.Address
+	Quorum uint64
+}, error) {
+	return _IInfoSync.Contract.GetSyncProgress(&_IInfoSync.CallOpts, chainID, height, infoHash)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
@@ -289,6 +690,27 @@ func (_IInfoSync *IInfoSyncCallerSession) Name() (string, error) {
 	return _IInfoSync.Contract.Name(&_IInfoSync.CallOpts)
 }
 
+// PinRootInfo is a paid mutator transaction binding the contract method 0x39efbcee.
+//
+// Solidity: function pinRootInfo(uint64 chainID, uint64 height, bool pinned) returns(bool)
+func (_IInfoSync *IInfoSyncTransactor) PinRootInfo(opts *bind.TransactOpts, chainID uint64, height uint64, pinned bool) (*types.Transaction, error) {
+	return _IInfoSync.contract.Transact(opts, "pinRootInfo", chainID, height, pinned)
+}
+
+// PinRootInfo is a paid mutator transaction binding the contract method 0x39efbcee.
+//
+// Solidity: function pinRootInfo(uint64 chainID, uint64 height, bool pinned) returns(bool)
+func (_IInfoSync *IInfoSyncSession) PinRootInfo(chainID uint64, height uint64, pinned bool) (*types.Transaction, error) {
+	return _IInfoSync.Contract.PinRootInfo(&_IInfoSync.TransactOpts, chainID, height, pinned)
+}
+
+// PinRootInfo is a paid mutator transaction binding the contract method 0x39efbcee.
+//
+// Solidity: function pinRootInfo(uint64 chainID, uint64 height, bool pinned) returns(bool)
+func (_IInfoSync *IInfoSyncTransactorSession) PinRootInfo(chainID uint64, height uint64, pinned bool) (*types.Transaction, error) {
+	return _IInfoSync.Contract.PinRootInfo(&_IInfoSync.TransactOpts, chainID, height, pinned)
+}
+
 // Replenish is a paid mutator transaction binding the contract method 0x69ce93b4.
 //
 // Solidity: function replenish(uint64 chainID, uint32[] heights) returns(bool)
@@ -310,6 +732,48 @@ func (_IInfoSync *IInfoSyncTransactorSession) Replenish(chainID uint64, heights
 	return _IInfoSync.Contract.Replenish(&_IInfoSync.TransactOpts, chainID, heights)
 }
 
+// Replenish64 is a paid mutator transaction binding the contract method 0xd7633c0d.
+//
+// Solidity: function replenish64(uint64 chainID, uint64[] heights) returns(bool)
+func (_IInfoSync *IInfoSyncTransactor) Replenish64(opts *bind.TransactOpts, chainID uint64, heights []uint64) (*types.Transaction, error) {
+	return _IInfoSync.contract.Transact(opts, "replenish64", chainID, heights)
+}
+
+// Replenish64 is a paid mutator transaction binding the contract method 0xd7633c0d.
+//
+// Solidity: function replenish64(uint64 chainID, uint64[] heights) returns(bool)
+func (_IInfoSync *IInfoSyncSession) Replenish64(chainID uint64, heights []uint64) (*types.Transaction, error) {
+	return _IInfoSync.Contract.Replenish64(&_IInfoSync.TransactOpts, chainID, heights)
+}
+
+// Replenish64 is a paid mutator transaction binding the contract method 0xd7633c0d.
+//
+// Solidity: function replenish64(uint64 chainID, uint64[] heights) returns(bool)
+func (_IInfoSync *IInfoSyncTransactorSession) Replenish64(chainID uint64, heights []uint64) (*types.Transaction, error) {
+	return _IInfoSync.Contract.Replenish64(&_IInfoSync.TransactOpts, chainID, heights)
+}
+
+// SetRetention is a paid mutator transaction binding the contract method 0xc89b0a55.
+//
+// Solidity: function setRetention(uint64 chainID, uint64 window) returns(bool)
+func (_IInfoSync *IInfoSyncTransactor) SetRetention(opts *bind.TransactOpts, chainID uint64, window uint64) (*types.Transaction, error) {
+	return _IInfoSync.contract.Transact(opts, "setRetention", chainID, window)
+}
+
+// SetRetention is a paid mutator transaction binding the contract method 0xc89b0a55.
+//
+// Solidity: function setRetention(uint64 chainID, uint64 window) returns(bool)
+func (_IInfoSync *IInfoSyncSession) SetRetention(chainID uint64, window uint64) (*types.Transaction, error) {
+	return _IInfoSync.Contract.SetRetention(&_IInfoSync.TransactOpts, chainID, window)
+}
+
+// SetRetention is a paid mutator transaction binding the contract method 0xc89b0a55.
+//
+// Solidity: function setRetention(uint64 chainID, uint64 window) returns(bool)
+func (_IInfoSync *IInfoSyncTransactorSession) SetRetention(chainID uint64, window uint64) (*types.Transaction, error) {
+	return _IInfoSync.Contract.SetRetention(&_IInfoSync.TransactOpts, chainID, window)
+}
+
 // SyncRootInfo is a paid mutator transaction binding the contract method 0x1413cc01.
 //
 // Solidity: function syncRootInfo(uint64 chainID, bytes[] rootInfos, bytes signature) returns(bool)
@@ -331,9 +795,30 @@ func (_IInfoSync *IInfoSyncTransactorSession) SyncRootInfo(chainID uint64, rootI
 	return _IInfoSync.Contract.SyncRootInfo(&_IInfoSync.TransactOpts, chainID, rootInfos, signature)
 }
 
-// IInfoSyncReplenishEventIterator is returned from FilterReplenishEvent and is used to iterate over the raw logs and unpacked data for ReplenishEvent events raised by the IInfoSync contract.
-type IInfoSyncReplenishEventIterator struct {
-	Event *IInfoSyncReplenishEvent // Event containing the contract specifics and raw log
+// SyncRootInfoAggregated is a paid mutator transaction binding the contract method 0x5e70b4c5.
+//
+// Solidity: function syncRootInfoAggregated(uint64 chainID, bytes[] rootInfos, bytes[] signatures) returns(bool)
+func (_IInfoSync *IInfoSyncTransactor) SyncRootInfoAggregated(opts *bind.TransactOpts, chainID uint64, rootInfos [][]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _IInfoSync.contract.Transact(opts, "syncRootInfoAggregated", chainID, rootInfos, signatures)
+}
+
+// SyncRootInfoAggregated is a paid mutator transaction binding the contract method 0x5e70b4c5.
+//
+// Solidity: function syncRootInfoAggregated(uint64 chainID, bytes[] rootInfos, bytes[] signatures) returns(bool)
+func (_IInfoSync *IInfoSyncSession) SyncRootInfoAggregated(chainID uint64, rootInfos [][]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _IInfoSync.Contract.SyncRootInfoAggregated(&_IInfoSync.TransactOpts, chainID, rootInfos, signatures)
+}
+
+// SyncRootInfoAggregated is a paid mutator transaction binding the contract method 0x5e70b4c5.
+//
+// Solidity: function syncRootInfoAggregated(uint64 chainID, bytes[] rootInfos, bytes[] signatures) returns(bool)
+func (_IInfoSync *IInfoSyncTransactorSession) SyncRootInfoAggregated(chainID uint64, rootInfos [][]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _IInfoSync.Contract.SyncRootInfoAggregated(&_IInfoSync.TransactOpts, chainID, rootInfos, signatures)
+}
+
+// IInfoSyncReplenish64EventIterator is returned from FilterReplenish64Event and is used to iterate over the raw logs and unpacked data for Replenish64Event events raised by the IInfoSync contract.
+type IInfoSyncReplenish64EventIterator struct {
+	Event *IInfoSyncReplenish64Event // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -347,7 +832,7 @@ type IInfoSyncReplenishEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IInfoSyncReplenishEventIterator) Next() bool {
+func (it *IInfoSyncReplenish64EventIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -356,7 +841,7 @@ func (it *IInfoSyncReplenishEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IInfoSyncReplenishEvent)
+			it.Event = new(IInfoSyncReplenish64Event)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -371,7 +856,7 @@ func (it *IInfoSyncReplenishEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IInfoSyncReplenishEvent)
+		it.Event = new(IInfoSyncReplenish64Event)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -387,42 +872,42 @@ func (it *IInfoSyncReplenishEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IInfoSyncReplenishEventIterator) Error() error {
+func (it *IInfoSyncReplenish64EventIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IInfoSyncReplenishEventIterator) Close() error {
+func (it *IInfoSyncReplenish64EventIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IInfoSyncReplenishEvent represents a ReplenishEvent event raised by the IInfoSync contract.
-type IInfoSyncReplenishEvent struct {
-	Heights []uint32
+// IInfoSyncReplenish64Event represents a Replenish64Event event raised by the IInfoSync contract.
+type IInfoSyncReplenish64Event struct {
+	Heights []uint64
 	ChainID uint64
 	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterReplenishEvent is a free log retrieval operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+// FilterReplenish64Event is a free log retrieval operation binding the contract event 0xc137ce1a55493e5278d1006b627cebde0193d2045eea936e1442f759e1b4ff00.
 //
-// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
-func (_IInfoSync *IInfoSyncFilterer) FilterReplenishEvent(opts *bind.FilterOpts) (*IInfoSyncReplenishEventIterator, error) {
+// Solidity: event Replenish64Event(uint64[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) FilterReplenish64Event(opts *bind.FilterOpts) (*IInfoSyncReplenish64EventIterator, error) {
 
-	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "ReplenishEvent")
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "Replenish64Event")
 	if err != nil {
 		return nil, err
 	}
-	return &IInfoSyncReplenishEventIterator{contract: _IInfoSync.contract, event: "ReplenishEvent", logs: logs, sub: sub}, nil
+	return &IInfoSyncReplenish64EventIterator{contract: _IInfoSync.contract, event: "Replenish64Event", logs: logs, sub: sub}, nil
 }
 
-// WatchReplenishEvent is a free log subscription operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+// WatchReplenish64Event is a free log subscription operation binding the contract event 0xc137ce1a55493e5278d1006b627cebde0193d2045eea936e1442f759e1b4ff00.
 //
-// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
-func (_IInfoSync *IInfoSyncFilterer) WatchReplenishEvent(opts *bind.WatchOpts, sink chan<- *IInfoSyncReplenishEvent) (event.Subscription, error) {
+// Solidity: event Replenish64Event(uint64[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) WatchReplenish64Event(opts *bind.WatchOpts, sink chan<- *IInfoSyncReplenish64Event) (event.Subscription, error) {
 
-	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "ReplenishEvent")
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "Replenish64Event")
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +917,8 @@ func (_IInfoSync *IInfoSyncFilterer) WatchReplenishEvent(opts *bind.WatchOpts, s
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IInfoSyncReplenishEvent)
-				if err := _IInfoSync.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+				event := new(IInfoSyncReplenish64Event)
+				if err := _IInfoSync.contract.UnpackLog(event, "Replenish64Event", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -454,21 +939,21 @@ func (_IInfoSync *IInfoSyncFilterer) WatchReplenishEvent(opts *bind.WatchOpts, s
 	}), nil
 }
 
-// ParseReplenishEvent is a log parse operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+// ParseReplenish64Event is a log parse operation binding the contract event 0xc137ce1a55493e5278d1006b627cebde0193d2045eea936e1442f759e1b4ff00.
 //
-// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
-func (_IInfoSync *IInfoSyncFilterer) ParseReplenishEvent(log types.Log) (*IInfoSyncReplenishEvent, error) {
-	event := new(IInfoSyncReplenishEvent)
-	if err := _IInfoSync.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+// Solidity: event Replenish64Event(uint64[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) ParseReplenish64Event(log types.Log) (*IInfoSyncReplenish64Event, error) {
+	event := new(IInfoSyncReplenish64Event)
+	if err := _IInfoSync.contract.UnpackLog(event, "Replenish64Event", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IInfoSyncSyncRootInfoEventIterator is returned from FilterSyncRootInfoEvent and is used to iterate over the raw logs and unpacked data for SyncRootInfoEvent events raised by the IInfoSync contract.
-type IInfoSyncSyncRootInfoEventIterator struct {
-	Event *IInfoSyncSyncRootInfoEvent // Event containing the contract specifics and raw log
+// IInfoSyncReplenishEventIterator is returned from FilterReplenishEvent and is used to iterate over the raw logs and unpacked data for ReplenishEvent events raised by the IInfoSync contract.
+type IInfoSyncReplenishEventIterator struct {
+	Event *IInfoSyncReplenishEvent // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -482,7 +967,7 @@ type IInfoSyncSyncRootInfoEventIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IInfoSyncSyncRootInfoEventIterator) Next() bool {
+func (it *IInfoSyncReplenishEventIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -491,7 +976,7 @@ func (it *IInfoSyncSyncRootInfoEventIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IInfoSyncSyncRootInfoEvent)
+			it.Event = new(IInfoSyncReplenishEvent)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -506,7 +991,7 @@ func (it *IInfoSyncSyncRootInfoEventIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IInfoSyncSyncRootInfoEvent)
+		it.Event = new(IInfoSyncReplenishEvent)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -522,25 +1007,840 @@ func (it *IInfoSyncSyncRootInfoEventIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IInfoSyncSyncRootInfoEventIterator) Error() error {
+func (it *IInfoSyncReplenishEventIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IInfoSyncSyncRootInfoEventIterator) Close() error {
+func (it *IInfoSyncReplenishEventIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IInfoSyncSyncRootInfoEvent represents a SyncRootInfoEvent event raised by the IInfoSync contract.
-type IInfoSyncSyncRootInfoEvent struct {
-	ChainID     uint64
-	Height      uint32
-	BlockHeight *big.Int
-	Raw         types.Log // Blockchain specific contextual infos
-}
-
+// IInfoSyncReplenishEvent represents a ReplenishEvent event raised by the IInfoSync contract.
+type IInfoSyncReplenishEvent struct {
+	Heights []uint32
+	ChainID uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterReplenishEvent is a free log retrieval operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+//
+// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) FilterReplenishEvent(opts *bind.FilterOpts) (*IInfoSyncReplenishEventIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "ReplenishEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncReplenishEventIterator{contract: _IInfoSync.contract, event: "ReplenishEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchReplenishEvent is a free log subscription operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+//
+// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) WatchReplenishEvent(opts *bind.WatchOpts, sink chan<- *IInfoSyncReplenishEvent) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "ReplenishEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncReplenishEvent)
+				if err := _IInfoSync.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReplenishEvent is a log parse operation binding the contract event 0x21af63b15a3c411234e8b1e975c9a0ea23ac5f43bca2f0a451042297560cd973.
+//
+// Solidity: event ReplenishEvent(uint32[] heights, uint64 chainID)
+func (_IInfoSync *IInfoSyncFilterer) ParseReplenishEvent(log types.Log) (*IInfoSyncReplenishEvent, error) {
+	event := new(IInfoSyncReplenishEvent)
+	if err := _IInfoSync.contract.UnpackLog(event, "ReplenishEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncRetentionUpdatedIterator is returned from FilterRetentionUpdated and is used to iterate over the raw logs and unpacked data for RetentionUpdated events raised by the IInfoSync contract.
+type IInfoSyncRetentionUpdatedIterator struct {
+	Event *IInfoSyncRetentionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncRetentionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncRetentionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncRetentionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncRetentionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncRetentionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncRetentionUpdated represents a RetentionUpdated event raised by the IInfoSync contract.
+type IInfoSyncRetentionUpdated struct {
+	ChainID uint64
+	Window  uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRetentionUpdated is a free log retrieval operation binding the contract event 0xb8af943ea178486dfd982fa77a9fed06877ed72dcb470be768008695d59f9fd6.
+//
+// Solidity: event RetentionUpdated(uint64 chainID, uint64 window)
+func (_IInfoSync *IInfoSyncFilterer) FilterRetentionUpdated(opts *bind.FilterOpts) (*IInfoSyncRetentionUpdatedIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "RetentionUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncRetentionUpdatedIterator{contract: _IInfoSync.contract, event: "RetentionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRetentionUpdated is a free log subscription operation binding the contract event 0xb8af943ea178486dfd982fa77a9fed06877ed72dcb470be768008695d59f9fd6.
+//
+// Solidity: event RetentionUpdated(uint64 chainID, uint64 window)
+func (_IInfoSync *IInfoSyncFilterer) WatchRetentionUpdated(opts *bind.WatchOpts, sink chan<- *IInfoSyncRetentionUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "RetentionUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncRetentionUpdated)
+				if err := _IInfoSync.contract.UnpackLog(event, "RetentionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRetentionUpdated is a log parse operation binding the contract event 0xb8af943ea178486dfd982fa77a9fed06877ed72dcb470be768008695d59f9fd6.
+//
+// Solidity: event RetentionUpdated(uint64 chainID, uint64 window)
+func (_IInfoSync *IInfoSyncFilterer) ParseRetentionUpdated(log types.Log) (*IInfoSyncRetentionUpdated, error) {
+	event := new(IInfoSyncRetentionUpdated)
+	if err := _IInfoSync.contract.UnpackLog(event, "RetentionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncRootInfoConflictIterator is returned from FilterRootInfoConflict and is used to iterate over the raw logs and unpacked data for RootInfoConflict events raised by the IInfoSync contract.
+type IInfoSyncRootInfoConflictIterator struct {
+	Event *IInfoSyncRootInfoConflict // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncRootInfoConflictIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncRootInfoConflict)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncRootInfoConflict)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncRootInfoConflictIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncRootInfoConflictIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncRootInfoConflict represents a RootInfoConflict event raised by the IInfoSync contract.
+type IInfoSyncRootInfoConflict struct {
+	ChainID      uint64
+	Height       uint64
+	Voter        common.Address
+	InfoHash     [32]byte
+	ConflictHash [32]byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterRootInfoConflict is a free log retrieval operation binding the contract event 0x37ca118012c9f6a2f440339c0844064b9a275c4c6a37960d312cd1f381f1a999.
+//
+// Solidity: event RootInfoConflict(uint64 chainID, uint64 height, address voter, bytes32 infoHash, bytes32 conflictHash)
+func (_IInfoSync *IInfoSyncFilterer) FilterRootInfoConflict(opts *bind.FilterOpts) (*IInfoSyncRootInfoConflictIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "RootInfoConflict")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncRootInfoConflictIterator{contract: _IInfoSync.contract, event: "RootInfoConflict", logs: logs, sub: sub}, nil
+}
+
+// WatchRootInfoConflict is a free log subscription operation binding the contract event 0x37ca118012c9f6a2f440339c0844064b9a275c4c6a37960d312cd1f381f1a999.
+//
+// Solidity: event RootInfoConflict(uint64 chainID, uint64 height, address voter, bytes32 infoHash, bytes32 conflictHash)
+func (_IInfoSync *IInfoSyncFilterer) WatchRootInfoConflict(opts *bind.WatchOpts, sink chan<- *IInfoSyncRootInfoConflict) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "RootInfoConflict")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncRootInfoConflict)
+				if err := _IInfoSync.contract.UnpackLog(event, "RootInfoConflict", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRootInfoConflict is a log parse operation binding the contract event 0x37ca118012c9f6a2f440339c0844064b9a275c4c6a37960d312cd1f381f1a999.
+//
+// Solidity: event RootInfoConflict(uint64 chainID, uint64 height, address voter, bytes32 infoHash, bytes32 conflictHash)
+func (_IInfoSync *IInfoSyncFilterer) ParseRootInfoConflict(log types.Log) (*IInfoSyncRootInfoConflict, error) {
+	event := new(IInfoSyncRootInfoConflict)
+	if err := _IInfoSync.contract.UnpackLog(event, "RootInfoConflict", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncRootInfoPinnedIterator is returned from FilterRootInfoPinned and is used to iterate over the raw logs and unpacked data for RootInfoPinned events raised by the IInfoSync contract.
+type IInfoSyncRootInfoPinnedIterator struct {
+	Event *IInfoSyncRootInfoPinned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncRootInfoPinnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncRootInfoPinned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncRootInfoPinned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncRootInfoPinnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncRootInfoPinnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncRootInfoPinned represents a RootInfoPinned event raised by the IInfoSync contract.
+type IInfoSyncRootInfoPinned struct {
+	ChainID uint64
+	Height  uint64
+	Pinned  bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRootInfoPinned is a free log retrieval operation binding the contract event 0xfc2fdd5874e5c9aa87ccb0e0ac4df097ef31ca62df30455933f001511f3d5633.
+//
+// Solidity: event RootInfoPinned(uint64 chainID, uint64 height, bool pinned)
+func (_IInfoSync *IInfoSyncFilterer) FilterRootInfoPinned(opts *bind.FilterOpts) (*IInfoSyncRootInfoPinnedIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "RootInfoPinned")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncRootInfoPinnedIterator{contract: _IInfoSync.contract, event: "RootInfoPinned", logs: logs, sub: sub}, nil
+}
+
+// WatchRootInfoPinned is a free log subscription operation binding the contract event 0xfc2fdd5874e5c9aa87ccb0e0ac4df097ef31ca62df30455933f001511f3d5633.
+//
+// Solidity: event RootInfoPinned(uint64 chainID, uint64 height, bool pinned)
+func (_IInfoSync *IInfoSyncFilterer) WatchRootInfoPinned(opts *bind.WatchOpts, sink chan<- *IInfoSyncRootInfoPinned) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "RootInfoPinned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncRootInfoPinned)
+				if err := _IInfoSync.contract.UnpackLog(event, "RootInfoPinned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRootInfoPinned is a log parse operation binding the contract event 0xfc2fdd5874e5c9aa87ccb0e0ac4df097ef31ca62df30455933f001511f3d5633.
+//
+// Solidity: event RootInfoPinned(uint64 chainID, uint64 height, bool pinned)
+func (_IInfoSync *IInfoSyncFilterer) ParseRootInfoPinned(log types.Log) (*IInfoSyncRootInfoPinned, error) {
+	event := new(IInfoSyncRootInfoPinned)
+	if err := _IInfoSync.contract.UnpackLog(event, "RootInfoPinned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncRootInfoPrunedIterator is returned from FilterRootInfoPruned and is used to iterate over the raw logs and unpacked data for RootInfoPruned events raised by the IInfoSync contract.
+type IInfoSyncRootInfoPrunedIterator struct {
+	Event *IInfoSyncRootInfoPruned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncRootInfoPrunedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncRootInfoPruned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncRootInfoPruned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncRootInfoPrunedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncRootInfoPrunedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncRootInfoPruned represents a RootInfoPruned event raised by the IInfoSync contract.
+type IInfoSyncRootInfoPruned struct {
+	ChainID      uint64
+	PrunedHeight uint64
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterRootInfoPruned is a free log retrieval operation binding the contract event 0x23d489373403bdae85adbefdfaa57c82c240479a1ec4bc298b6d869a2ad31aec.
+//
+// Solidity: event RootInfoPruned(uint64 chainID, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncFilterer) FilterRootInfoPruned(opts *bind.FilterOpts) (*IInfoSyncRootInfoPrunedIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "RootInfoPruned")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncRootInfoPrunedIterator{contract: _IInfoSync.contract, event: "RootInfoPruned", logs: logs, sub: sub}, nil
+}
+
+// WatchRootInfoPruned is a free log subscription operation binding the contract event 0x23d489373403bdae85adbefdfaa57c82c240479a1ec4bc298b6d869a2ad31aec.
+//
+// Solidity: event RootInfoPruned(uint64 chainID, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncFilterer) WatchRootInfoPruned(opts *bind.WatchOpts, sink chan<- *IInfoSyncRootInfoPruned) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "RootInfoPruned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncRootInfoPruned)
+				if err := _IInfoSync.contract.UnpackLog(event, "RootInfoPruned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRootInfoPruned is a log parse operation binding the contract event 0x23d489373403bdae85adbefdfaa57c82c240479a1ec4bc298b6d869a2ad31aec.
+//
+// Solidity: event RootInfoPruned(uint64 chainID, uint64 prunedHeight)
+func (_IInfoSync *IInfoSyncFilterer) ParseRootInfoPruned(log types.Log) (*IInfoSyncRootInfoPruned, error) {
+	event := new(IInfoSyncRootInfoPruned)
+	if err := _IInfoSync.contract.UnpackLog(event, "RootInfoPruned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncSyncRootInfo64EventIterator is returned from FilterSyncRootInfo64Event and is used to iterate over the raw logs and unpacked data for SyncRootInfo64Event events raised by the IInfoSync contract.
+type IInfoSyncSyncRootInfo64EventIterator struct {
+	Event *IInfoSyncSyncRootInfo64Event // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncSyncRootInfo64EventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncSyncRootInfo64Event)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncSyncRootInfo64Event)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncSyncRootInfo64EventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncSyncRootInfo64EventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncSyncRootInfo64Event represents a SyncRootInfo64Event event raised by the IInfoSync contract.
+type IInfoSyncSyncRootInfo64Event struct {
+	ChainID     uint64
+	Height      uint64
+	BlockHeight *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSyncRootInfo64Event is a free log retrieval operation binding the contract event 0x76eff0972fbe5e08ff0a223b3a480f2f0d4b680a1b12d64f0d2204b85fb397b6.
+//
+// Solidity: event SyncRootInfo64Event(uint64 chainID, uint64 height, uint256 BlockHeight)
+func (_IInfoSync *IInfoSyncFilterer) FilterSyncRootInfo64Event(opts *bind.FilterOpts) (*IInfoSyncSyncRootInfo64EventIterator, error) {
+
+	logs, sub, err := _IInfoSync.contract.FilterLogs(opts, "SyncRootInfo64Event")
+	if err != nil {
+		return nil, err
+	}
+	return &IInfoSyncSyncRootInfo64EventIterator{contract: _IInfoSync.contract, event: "SyncRootInfo64Event", logs: logs, sub: sub}, nil
+}
+
+// WatchSyncRootInfo64Event is a free log subscription operation binding the contract event 0x76eff0972fbe5e08ff0a223b3a480f2f0d4b680a1b12d64f0d2204b85fb397b6.
+//
+// Solidity: event SyncRootInfo64Event(uint64 chainID, uint64 height, uint256 BlockHeight)
+func (_IInfoSync *IInfoSyncFilterer) WatchSyncRootInfo64Event(opts *bind.WatchOpts, sink chan<- *IInfoSyncSyncRootInfo64Event) (event.Subscription, error) {
+
+	logs, sub, err := _IInfoSync.contract.WatchLogs(opts, "SyncRootInfo64Event")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IInfoSyncSyncRootInfo64Event)
+				if err := _IInfoSync.contract.UnpackLog(event, "SyncRootInfo64Event", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSyncRootInfo64Event is a log parse operation binding the contract event 0x76eff0972fbe5e08ff0a223b3a480f2f0d4b680a1b12d64f0d2204b85fb397b6.
+//
+// Solidity: event SyncRootInfo64Event(uint64 chainID, uint64 height, uint256 BlockHeight)
+func (_IInfoSync *IInfoSyncFilterer) ParseSyncRootInfo64Event(log types.Log) (*IInfoSyncSyncRootInfo64Event, error) {
+	event := new(IInfoSyncSyncRootInfo64Event)
+	if err := _IInfoSync.contract.UnpackLog(event, "SyncRootInfo64Event", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IInfoSyncSyncRootInfoEventIterator is returned from FilterSyncRootInfoEvent and is used to iterate over the raw logs and unpacked data for SyncRootInfoEvent events raised by the IInfoSync contract.
+type IInfoSyncSyncRootInfoEventIterator struct {
+	Event *IInfoSyncSyncRootInfoEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IInfoSyncSyncRootInfoEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IInfoSyncSyncRootInfoEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IInfoSyncSyncRootInfoEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IInfoSyncSyncRootInfoEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IInfoSyncSyncRootInfoEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IInfoSyncSyncRootInfoEvent represents a SyncRootInfoEvent event raised by the IInfoSync contract.
+type IInfoSyncSyncRootInfoEvent struct {
+	ChainID     uint64
+	Height      uint32
+	BlockHeight *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
 // FilterSyncRootInfoEvent is a free log retrieval operation binding the contract event 0x24cd873c72270d09dcf64a7c56ff70129c04f398e38116d7ad773d43340509e2.
 //
 // Solidity: event SyncRootInfoEvent(uint64 chainID, uint32 height, uint256 BlockHeight)
@@ -601,3 +1901,4 @@ func (_IInfoSync *IInfoSyncFilterer) ParseSyncRootInfoEvent(log types.Log) (*IIn
 	event.Raw = log
 	return event, nil
 }
+
@@ -26,6 +26,22 @@ var (
 	_ = event.NewSubscription
 )
 
+// ISideChainManagerAsset is an auto generated low-level Go binding around an user-defined struct.
+type ISideChainManagerAsset struct {
+	ChainID  uint64
+	Asset    []byte
+	Symbol   string
+	Decimals uint8
+	Mode     uint8
+}
+
+// ISideChainManagerFeeRecord is an auto generated low-level Go binding around an user-defined struct.
+type ISideChainManagerFeeRecord struct {
+	View   uint64
+	Fee    *big.Int
+	Height uint64
+}
+
 // ISideChainManagerSideChain is an auto generated low-level Go binding around an user-defined struct.
 type ISideChainManagerSideChain struct {
 	Owner       common.Address
@@ -37,54 +53,157 @@ type ISideChainManagerSideChain struct {
 }
 
 var (
+	MethodAcceptOwnership = "acceptOwnership"
+
+	MethodApproveAsset = "approveAsset"
+
 	MethodApproveQuitSideChain = "approveQuitSideChain"
 
 	MethodApproveRegisterSideChain = "approveRegisterSideChain"
 
 	MethodApproveUpdateSideChain = "approveUpdateSideChain"
 
+	MethodCancelRequest = "cancelRequest"
+
 	MethodQuitSideChain = "quitSideChain"
 
+	MethodRecoverOwnership = "recoverOwnership"
+
 	MethodRegisterAsset = "registerAsset"
 
 	MethodRegisterSideChain = "registerSideChain"
 
+	MethodRejectRequest = "rejectRequest"
+
+	MethodRemoveAsset = "removeAsset"
+
+	MethodSetBondParams = "setBondParams"
+
+	MethodSetFeeParams = "setFeeParams"
+
+	MethodSetSideChainStatus = "setSideChainStatus"
+
+	MethodTransferOwnership = "transferOwnership"
+
 	MethodUpdateFee = "updateFee"
 
 	MethodUpdateSideChain = "updateSideChain"
 
+	MethodGetAllSideChains = "getAllSideChains"
+
+	MethodGetAsset = "getAsset"
+
+	MethodGetAssetCount = "getAssetCount"
+
+	MethodGetAssets = "getAssets"
+
+	MethodGetBondParams = "getBondParams"
+
 	MethodGetFee = "getFee"
 
+	MethodGetFeeHistory = "getFeeHistory"
+
+	MethodGetFeeInfo = "getFeeInfo"
+
+	MethodGetFeeParams = "getFeeParams"
+
+	MethodGetPendingOwner = "getPendingOwner"
+
+	MethodGetPendingRequest = "getPendingRequest"
+
+	MethodGetPendingRequests = "getPendingRequests"
+
+	MethodGetRequestBond = "getRequestBond"
+
 	MethodGetSideChain = "getSideChain"
 
+	MethodGetSideChainAtHeight = "getSideChainAtHeight"
+
+	MethodGetSideChainCount = "getSideChainCount"
+
+	MethodGetSideChainStatus = "getSideChainStatus"
+
 	EventApproveQuitSideChain = "ApproveQuitSideChain"
 
 	EventApproveRegisterSideChain = "ApproveRegisterSideChain"
 
 	EventApproveUpdateSideChain = "ApproveUpdateSideChain"
 
+	EventAssetApproved = "AssetApproved"
+
+	EventAssetRemoved = "AssetRemoved"
+
+	EventBondForfeited = "BondForfeited"
+
+	EventBondParamsUpdated = "BondParamsUpdated"
+
+	EventBondRefunded = "BondRefunded"
+
+	EventCancelRequest = "CancelRequest"
+
+	EventFeeParamsUpdated = "FeeParamsUpdated"
+
+	EventFeeUpdated = "FeeUpdated"
+
+	EventOwnershipRecovered = "OwnershipRecovered"
+
+	EventOwnershipTransferStarted = "OwnershipTransferStarted"
+
+	EventOwnershipTransferred = "OwnershipTransferred"
+
 	EventQuitSideChain = "QuitSideChain"
 
 	EventRegisterSideChain = "RegisterSideChain"
 
+	EventRejectRequest = "RejectRequest"
+
+	EventRequestExpired = "RequestExpired"
+
+	EventSideChainStatusChanged = "SideChainStatusChanged"
+
 	EventUpdateSideChain = "UpdateSideChain"
 )
 
 // ISideChainManagerABI is the input ABI used to generate the binding from.
-const ISideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"QuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"RegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"UpdateSideChain\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFee\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getSideChain\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"quitSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"AssetMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"AssetMapValue\",\"type\":\"bytes[]\"},{\"internalType\":\"uint64[]\",\"name\":\"LockProxyMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"LockProxyMapValue\",\"type\":\"bytes[]\"}],\"name\":\"registerAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"viewNum\",\"type\":\"uint64\"},{\"internalType\":\"int256\",\"name\":\"fee\",\"type\":\"int256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"updateFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"updateSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const ISideChainManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveQuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveRegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"ApproveUpdateSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Asset\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Symbol\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Decimals\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Mode\",\"type\":\"uint8\"}],\"name\":\"AssetApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"Asset\",\"type\":\"bytes\"}],\"name\":\"AssetRemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"RequestType\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Payer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"name\":\"BondForfeited\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"RegisterBond\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"UpdateBond\",\"type\":\"uint256\"}],\"name\":\"BondParamsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"RequestType\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Payer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Amount\",\"type\":\"uint256\"}],\"name\":\"BondRefunded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"RequestType\",\"type\":\"uint8\"}],\"name\":\"CancelRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Multiplier\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"MinFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"MaxFee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Timeout\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"StakeWeighted\",\"type\":\"bool\"}],\"name\":\"FeeParamsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"View\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"Fee\",\"type\":\"uint256\"}],\"name\":\"FeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"PreviousOwner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"NewOwner\",\"type\":\"address\"}],\"name\":\"OwnershipRecovered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"Owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"NewOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferStarted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"PreviousOwner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"NewOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"}],\"name\":\"QuitSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"RegisterSideChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"RequestType\",\"type\":\"uint8\"}],\"name\":\"RejectRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"RequestType\",\"type\":\"uint8\"}],\"name\":\"RequestExpired\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"PreviousStatus\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"Status\",\"type\":\"uint8\"}],\"name\":\"SideChainStatusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"ChainId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"Router\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"Name\",\"type\":\"string\"}],\"name\":\"UpdateSideChain\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"acceptOwnership\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"mode\",\"type\":\"uint8\"}],\"name\":\"approveAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveQuitSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveRegisterSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"approveUpdateSideChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"requestType\",\"type\":\"uint8\"}],\"name\":\"cancelRequest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"}],\"name\":\"getAllSideChains\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain[]\",\"name\":\"sidechains\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"}],\"name\":\"getAsset\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"mode\",\"type\":\"uint8\"}],\"internalType\":\"structISideChainManager.Asset\",\"name\":\"entry\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getAssetCount\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"}],\"name\":\"getAssets\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"mode\",\"type\":\"uint8\"}],\"internalType\":\"structISideChainManager.Asset[]\",\"name\":\"assets\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBondParams\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"registerBond\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updateBond\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFee\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFeeHistory\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"view\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"internalType\":\"structISideChainManager.FeeRecord[]\",\"name\":\"records\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"viewNum\",\"type\":\"uint64\"}],\"name\":\"getFeeInfo\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"startHeight\",\"type\":\"uint64\"},{\"internalType\":\"address[]\",\"name\":\"voters\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"fees\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getFeeParams\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"multiplier\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"minFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxFee\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"timeout\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"stakeWeighted\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getPendingOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pendingOwner\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"requestType\",\"type\":\"uint8\"}],\"name\":\"getPendingRequest\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"expiry\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"requestType\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"}],\"name\":\"getPendingRequests\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain[]\",\"name\":\"sidechains\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"requestType\",\"type\":\"uint8\"}],\"name\":\"getRequestBond\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"payer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getSideChain\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getSideChainAtHeight\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"internalType\":\"structISideChainManager.SideChain\",\"name\":\"sidechain\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSideChainCount\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"getSideChainStatus\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"}],\"name\":\"quitSideChain\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"recoverOwnership\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64[]\",\"name\":\"AssetMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"AssetMapValue\",\"type\":\"bytes[]\"},{\"internalType\":\"uint64[]\",\"name\":\"LockProxyMapKey\",\"type\":\"uint64[]\"},{\"internalType\":\"bytes[]\",\"name\":\"LockProxyMapValue\",\"type\":\"bytes[]\"}],\"name\":\"registerAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"}],\"name\":\"registerSideChain\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"requestType\",\"type\":\"uint8\"}],\"name\":\"rejectRequest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"asset\",\"type\":\"bytes\"}],\"name\":\"removeAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"registerBond\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updateBond\",\"type\":\"uint256\"}],\"name\":\"setBondParams\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"multiplier\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"minFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxFee\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"timeout\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"stakeWeighted\",\"type\":\"bool\"}],\"name\":\"setFeeParams\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"}],\"name\":\"setSideChainStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"viewNum\",\"type\":\"uint64\"},{\"internalType\":\"int256\",\"name\":\"fee\",\"type\":\"int256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"updateFee\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"chainID\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"router\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"CCMCAddress\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"extraInfo\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"activationHeight\",\"type\":\"uint64\"}],\"name\":\"updateSideChain\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]"
 
 // ISideChainManagerFuncSigs maps the 4-byte function signature to its string representation.
 var ISideChainManagerFuncSigs = map[string]string{
+	"e7ec4f2d": "acceptOwnership(uint64)",
+	"2c9d31ce": "approveAsset(uint64,bytes,string,uint8,uint8)",
 	"9bcb64f0": "approveQuitSideChain(uint64)",
 	"c3e7746d": "approveRegisterSideChain(uint64)",
 	"678f0135": "approveUpdateSideChain(uint64)",
+	"11f703db": "cancelRequest(uint64,uint8)",
+	"796f3ebc": "getAllSideChains(uint64,uint64)",
+	"5b5cf2f1": "getAsset(uint64,bytes)",
+	"2187462d": "getAssetCount(uint64)",
+	"390e3a77": "getAssets(uint64,uint64,uint64)",
+	"7da63375": "getBondParams()",
 	"1982b1d0": "getFee(uint64)",
+	"bbe2978f": "getFeeHistory(uint64)",
+	"50b53192": "getFeeInfo(uint64,uint64)",
+	"f35d7fa6": "getFeeParams(uint64)",
+	"ef40a73f": "getPendingOwner(uint64)",
+	"a8c8c562": "getPendingRequest(uint64,uint8)",
+	"9f01a25e": "getPendingRequests(uint8,uint64,uint64)",
+	"50005b2a": "getRequestBond(uint64,uint8)",
 	"84838fb8": "getSideChain(uint64)",
+	"f4df6709": "getSideChainAtHeight(uint64,uint64)",
+	"5f5711cc": "getSideChainCount()",
+	"68b68f88": "getSideChainStatus(uint64)",
 	"78b94ab1": "quitSideChain(uint64)",
+	"a8d849c4": "recoverOwnership(uint64,address)",
 	"e171240f": "registerAsset(uint64,uint64[],bytes[],uint64[],bytes[])",
 	"3a24101f": "registerSideChain(uint64,uint64,string,bytes,bytes)",
+	"b29b5387": "rejectRequest(uint64,uint8)",
+	"ed3e9ab7": "removeAsset(uint64,bytes)",
+	"e86c95c8": "setBondParams(uint256,uint256)",
+	"ef2c9bcb": "setFeeParams(uint64,uint64,uint256,uint256,uint64,bool)",
+	"58161f7f": "setSideChainStatus(uint64,uint8)",
+	"0a94864e": "transferOwnership(uint64,address)",
 	"db5d3488": "updateFee(uint64,uint64,int256,bytes)",
-	"956f1463": "updateSideChain(uint64,uint64,string,bytes,bytes)",
+	"ea5fd28b": "updateSideChain(uint64,uint64,string,bytes,bytes,uint64)",
 }
 
 // ISideChainManager is an auto generated Go binding around an Ethereum contract.
@@ -229,6 +348,175 @@ func (_ISideChainManager *ISideChainManagerTransactorRaw) Transact(opts *bind.Tr
 	return _ISideChainManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetAllSideChains is a free data retrieval call binding the contract method 0x796f3ebc.
+//
+// Solidity: function getAllSideChains(uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerCaller) GetAllSideChains(opts *bind.CallOpts, offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getAllSideChains", offset, limit)
+
+	if err != nil {
+		return *new([]ISideChainManagerSideChain), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]ISideChainManagerSideChain)).(*[]ISideChainManagerSideChain)
+
+	return out0, err
+
+}
+
+// GetAllSideChains is a free data retrieval call binding the contract method 0x796f3ebc.
+//
+// Solidity: function getAllSideChains(uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerSession) GetAllSideChains(offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetAllSideChains(&_ISideChainManager.CallOpts, offset, limit)
+}
+
+// GetAllSideChains is a free data retrieval call binding the contract method 0x796f3ebc.
+//
+// Solidity: function getAllSideChains(uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetAllSideChains(offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetAllSideChains(&_ISideChainManager.CallOpts, offset, limit)
+}
+
+// GetAsset is a free data retrieval call binding the contract method 0x5b5cf2f1.
+//
+// Solidity: function getAsset(uint64 chainID, bytes asset) view returns((uint64,bytes,string,uint8,uint8) entry)
+func (_ISideChainManager *ISideChainManagerCaller) GetAsset(opts *bind.CallOpts, chainID uint64, asset []byte) (ISideChainManagerAsset, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getAsset", chainID, asset)
+
+	if err != nil {
+		return *new(ISideChainManagerAsset), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(ISideChainManagerAsset)).(*ISideChainManagerAsset)
+
+	return out0, err
+
+}
+
+// GetAsset is a free data retrieval call binding the contract method 0x5b5cf2f1.
+//
+// Solidity: function getAsset(uint64 chainID, bytes asset) view returns((uint64,bytes,string,uint8,uint8) entry)
+func (_ISideChainManager *ISideChainManagerSession) GetAsset(chainID uint64, asset []byte) (ISideChainManagerAsset, error) {
+	return _ISideChainManager.Contract.GetAsset(&_ISideChainManager.CallOpts, chainID, asset)
+}
+
+// GetAsset is a free data retrieval call binding the contract method 0x5b5cf2f1.
+//
+// Solidity: function getAsset(uint64 chainID, bytes asset) view returns((uint64,bytes,string,uint8,uint8) entry)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetAsset(chainID uint64, asset []byte) (ISideChainManagerAsset, error) {
+	return _ISideChainManager.Contract.GetAsset(&_ISideChainManager.CallOpts, chainID, asset)
+}
+
+// GetAssetCount is a free data retrieval call binding the contract method 0x2187462d.
+//
+// Solidity: function getAssetCount(uint64 chainID) view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerCaller) GetAssetCount(opts *bind.CallOpts, chainID uint64) (uint64, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getAssetCount", chainID)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetAssetCount is a free data retrieval call binding the contract method 0x2187462d.
+//
+// Solidity: function getAssetCount(uint64 chainID) view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerSession) GetAssetCount(chainID uint64) (uint64, error) {
+	return _ISideChainManager.Contract.GetAssetCount(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetAssetCount is a free data retrieval call binding the contract method 0x2187462d.
+//
+// Solidity: function getAssetCount(uint64 chainID) view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetAssetCount(chainID uint64) (uint64, error) {
+	return _ISideChainManager.Contract.GetAssetCount(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetAssets is a free data retrieval call binding the contract method 0x390e3a77.
+//
+// Solidity: function getAssets(uint64 chainID, uint64 offset, uint64 limit) view returns((uint64,bytes,string,uint8,uint8)[] assets)
+func (_ISideChainManager *ISideChainManagerCaller) GetAssets(opts *bind.CallOpts, chainID uint64, offset uint64, limit uint64) ([]ISideChainManagerAsset, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getAssets", chainID, offset, limit)
+
+	if err != nil {
+		return *new([]ISideChainManagerAsset), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]ISideChainManagerAsset)).(*[]ISideChainManagerAsset)
+
+	return out0, err
+
+}
+
+// GetAssets is a free data retrieval call binding the contract method 0x390e3a77.
+//
+// Solidity: function getAssets(uint64 chainID, uint64 offset, uint64 limit) view returns((uint64,bytes,string,uint8,uint8)[] assets)
+func (_ISideChainManager *ISideChainManagerSession) GetAssets(chainID uint64, offset uint64, limit uint64) ([]ISideChainManagerAsset, error) {
+	return _ISideChainManager.Contract.GetAssets(&_ISideChainManager.CallOpts, chainID, offset, limit)
+}
+
+// GetAssets is a free data retrieval call binding the contract method 0x390e3a77.
+//
+// Solidity: function getAssets(uint64 chainID, uint64 offset, uint64 limit) view returns((uint64,bytes,string,uint8,uint8)[] assets)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetAssets(chainID uint64, offset uint64, limit uint64) ([]ISideChainManagerAsset, error) {
+	return _ISideChainManager.Contract.GetAssets(&_ISideChainManager.CallOpts, chainID, offset, limit)
+}
+
+// GetBondParams is a free data retrieval call binding the contract method 0x7da63375.
+//
+// Solidity: function getBondParams() view returns(uint256 registerBond, uint256 updateBond)
+func (_ISideChainManager *ISideChainManagerCaller) GetBondParams(opts *bind.CallOpts) (struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+}, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getBondParams")
+
+	outstruct := new(struct {
+		RegisterBond *big.Int
+		UpdateBond   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RegisterBond = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.UpdateBond = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBondParams is a free data retrieval call binding the contract method 0x7da63375.
+//
+// Solidity: function getBondParams() view returns(uint256 registerBond, uint256 updateBond)
+func (_ISideChainManager *ISideChainManagerSession) GetBondParams() (struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetBondParams(&_ISideChainManager.CallOpts)
+}
+
+// GetBondParams is a free data retrieval call binding the contract method 0x7da63375.
+//
+// Solidity: function getBondParams() view returns(uint256 registerBond, uint256 updateBond)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetBondParams() (struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetBondParams(&_ISideChainManager.CallOpts)
+}
+
 // GetFee is a free data retrieval call binding the contract method 0x1982b1d0.
 //
 // Solidity: function getFee(uint64 chainID) view returns(bytes)
@@ -260,208 +548,2706 @@ func (_ISideChainManager *ISideChainManagerCallerSession) GetFee(chainID uint64)
 	return _ISideChainManager.Contract.GetFee(&_ISideChainManager.CallOpts, chainID)
 }
 
-// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
+// GetFeeHistory is a free data retrieval call binding the contract method 0xbbe2978f.
 //
-// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
-func (_ISideChainManager *ISideChainManagerCaller) GetSideChain(opts *bind.CallOpts, chainID uint64) (ISideChainManagerSideChain, error) {
+// Solidity: function getFeeHistory(uint64 chainID) view returns((uint64,uint256,uint64)[] records)
+func (_ISideChainManager *ISideChainManagerCaller) GetFeeHistory(opts *bind.CallOpts, chainID uint64) ([]ISideChainManagerFeeRecord, error) {
 	var out []interface{}
-	err := _ISideChainManager.contract.Call(opts, &out, "getSideChain", chainID)
+	err := _ISideChainManager.contract.Call(opts, &out, "getFeeHistory", chainID)
 
 	if err != nil {
-		return *new(ISideChainManagerSideChain), err
+		return *new([]ISideChainManagerFeeRecord), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(ISideChainManagerSideChain)).(*ISideChainManagerSideChain)
+	out0 := *abi.ConvertType(out[0], new([]ISideChainManagerFeeRecord)).(*[]ISideChainManagerFeeRecord)
 
 	return out0, err
 
 }
 
-// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
+// GetFeeHistory is a free data retrieval call binding the contract method 0xbbe2978f.
 //
-// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
-func (_ISideChainManager *ISideChainManagerSession) GetSideChain(chainID uint64) (ISideChainManagerSideChain, error) {
-	return _ISideChainManager.Contract.GetSideChain(&_ISideChainManager.CallOpts, chainID)
+// Solidity: function getFeeHistory(uint64 chainID) view returns((uint64,uint256,uint64)[] records)
+func (_ISideChainManager *ISideChainManagerSession) GetFeeHistory(chainID uint64) ([]ISideChainManagerFeeRecord, error) {
+	return _ISideChainManager.Contract.GetFeeHistory(&_ISideChainManager.CallOpts, chainID)
 }
 
-// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
+// GetFeeHistory is a free data retrieval call binding the contract method 0xbbe2978f.
 //
-// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
-func (_ISideChainManager *ISideChainManagerCallerSession) GetSideChain(chainID uint64) (ISideChainManagerSideChain, error) {
-	return _ISideChainManager.Contract.GetSideChain(&_ISideChainManager.CallOpts, chainID)
+// Solidity: function getFeeHistory(uint64 chainID) view returns((uint64,uint256,uint64)[] records)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetFeeHistory(chainID uint64) ([]ISideChainManagerFeeRecord, error) {
+	return _ISideChainManager.Contract.GetFeeHistory(&_ISideChainManager.CallOpts, chainID)
 }
 
-// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+// GetFeeInfo is a free data retrieval call binding the contract method 0x50b53192.
 //
-// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactor) ApproveQuitSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "approveQuitSideChain", chainID)
+// Solidity: function getFeeInfo(uint64 chainID, uint64 viewNum) view returns(uint64 startHeight, address[] voters, uint256[] fees)
+func (_ISideChainManager *ISideChainManagerCaller) GetFeeInfo(opts *bind.CallOpts, chainID uint64, viewNum uint64) (struct {
+	StartHeight uint64
+	Voters      []common.Address
+	Fees        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getFeeInfo", chainID, viewNum)
+
+	outstruct := new(struct {
+		StartHeight uint64
+		Voters      []common.Address
+		Fees        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StartHeight = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Voters = *abi.ConvertType(out[1], new([]common.Address)).(*[]common.Address)
+	outstruct.Fees = *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
 }
 
-// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+// GetFeeInfo is a free data retrieval call binding the contract method 0x50b53192.
 //
-// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerSession) ApproveQuitSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveQuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getFeeInfo(uint64 chainID, uint64 viewNum) view returns(uint64 startHeight, address[] voters, uint256[] fees)
+func (_ISideChainManager *ISideChainManagerSession) GetFeeInfo(chainID uint64, viewNum uint64) (struct {
+	StartHeight uint64
+	Voters      []common.Address
+	Fees        []*big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetFeeInfo(&_ISideChainManager.CallOpts, chainID, viewNum)
 }
 
-// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+// GetFeeInfo is a free data retrieval call binding the contract method 0x50b53192.
 //
-// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveQuitSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveQuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getFeeInfo(uint64 chainID, uint64 viewNum) view returns(uint64 startHeight, address[] voters, uint256[] fees)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetFeeInfo(chainID uint64, viewNum uint64) (struct {
+	StartHeight uint64
+	Voters      []common.Address
+	Fees        []*big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetFeeInfo(&_ISideChainManager.CallOpts, chainID, viewNum)
 }
 
-// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+// GetFeeParams is a free data retrieval call binding the contract method 0xf35d7fa6.
 //
-// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactor) ApproveRegisterSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "approveRegisterSideChain", chainID)
+// Solidity: function getFeeParams(uint64 chainID) view returns(uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted)
+func (_ISideChainManager *ISideChainManagerCaller) GetFeeParams(opts *bind.CallOpts, chainID uint64) (struct {
+	Multiplier    uint64
+	MinFee        *big.Int
+	MaxFee        *big.Int
+	Timeout       uint64
+	StakeWeighted bool
+}, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getFeeParams", chainID)
+
+	outstruct := new(struct {
+		Multiplier    uint64
+		MinFee        *big.Int
+		MaxFee        *big.Int
+		Timeout       uint64
+		StakeWeighted bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Multiplier = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.MinFee = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.MaxFee = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Timeout = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	outstruct.StakeWeighted = *abi.ConvertType(out[4], new(bool)).(*bool)
+
+	return *outstruct, err
+
 }
 
-// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+// GetFeeParams is a free data retrieval call binding the contract method 0xf35d7fa6.
 //
-// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerSession) ApproveRegisterSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveRegisterSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getFeeParams(uint64 chainID) view returns(uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted)
+func (_ISideChainManager *ISideChainManagerSession) GetFeeParams(chainID uint64) (struct {
+	Multiplier    uint64
+	MinFee        *big.Int
+	MaxFee        *big.Int
+	Timeout       uint64
+	StakeWeighted bool
+}, error) {
+	return _ISideChainManager.Contract.GetFeeParams(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetFeeParams is a free data retrieval call binding the contract method 0xf35d7fa6.
+//
+// Solidity: function getFeeParams(uint64 chainID) view returns(uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetFeeParams(chainID uint64) (struct {
+	Multiplier    uint64
+	MinFee        *big.Int
+	MaxFee        *big.Int
+	Timeout       uint64
+	StakeWeighted bool
+}, error) {
+	return _ISideChainManager.Contract.GetFeeParams(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetPendingOwner is a free data retrieval call binding the contract method 0xef40a73f.
+//
+// Solidity: function getPendingOwner(uint64 chainID) view returns(address pendingOwner)
+func (_ISideChainManager *ISideChainManagerCaller) GetPendingOwner(opts *bind.CallOpts, chainID uint64) (common.Address, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getPendingOwner", chainID)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
-// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+// GetPendingOwner is a free data retrieval call binding the contract method 0xef40a73f.
 //
-// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveRegisterSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveRegisterSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getPendingOwner(uint64 chainID) view returns(address pendingOwner)
+func (_ISideChainManager *ISideChainManagerSession) GetPendingOwner(chainID uint64) (common.Address, error) {
+	return _ISideChainManager.Contract.GetPendingOwner(&_ISideChainManager.CallOpts, chainID)
 }
 
-// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+// GetPendingOwner is a free data retrieval call binding the contract method 0xef40a73f.
 //
-// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactor) ApproveUpdateSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "approveUpdateSideChain", chainID)
+// Solidity: function getPendingOwner(uint64 chainID) view returns(address pendingOwner)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetPendingOwner(chainID uint64) (common.Address, error) {
+	return _ISideChainManager.Contract.GetPendingOwner(&_ISideChainManager.CallOpts, chainID)
 }
 
-// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+// GetPendingRequest is a free data retrieval call binding the contract method 0xa8c8c562.
 //
-// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerSession) ApproveUpdateSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveUpdateSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getPendingRequest(uint64 chainID, uint8 requestType) view returns((address,uint64,uint64,string,bytes,bytes) sidechain, uint64 expiry)
+func (_ISideChainManager *ISideChainManagerCaller) GetPendingRequest(opts *bind.CallOpts, chainID uint64, requestType uint8) (struct {
+	Sidechain ISideChainManagerSideChain
+	Expiry    uint64
+}, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getPendingRequest", chainID, requestType)
+
+	outstruct := new(struct {
+		Sidechain ISideChainManagerSideChain
+		Expiry    uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Sidechain = *abi.ConvertType(out[0], new(ISideChainManagerSideChain)).(*ISideChainManagerSideChain)
+	outstruct.Expiry = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
 }
 
-// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+// GetPendingRequest is a free data retrieval call binding the contract method 0xa8c8c562.
 //
-// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveUpdateSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.ApproveUpdateSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getPendingRequest(uint64 chainID, uint8 requestType) view returns((address,uint64,uint64,string,bytes,bytes) sidechain, uint64 expiry)
+func (_ISideChainManager *ISideChainManagerSession) GetPendingRequest(chainID uint64, requestType uint8) (struct {
+	Sidechain ISideChainManagerSideChain
+	Expiry    uint64
+}, error) {
+	return _ISideChainManager.Contract.GetPendingRequest(&_ISideChainManager.CallOpts, chainID, requestType)
 }
 
-// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+// GetPendingRequest is a free data retrieval call binding the contract method 0xa8c8c562.
 //
-// Solidity: function quitSideChain(uint64 chainID) returns()
-func (_ISideChainManager *ISideChainManagerTransactor) QuitSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "quitSideChain", chainID)
+// Solidity: function getPendingRequest(uint64 chainID, uint8 requestType) view returns((address,uint64,uint64,string,bytes,bytes) sidechain, uint64 expiry)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetPendingRequest(chainID uint64, requestType uint8) (struct {
+	Sidechain ISideChainManagerSideChain
+	Expiry    uint64
+}, error) {
+	return _ISideChainManager.Contract.GetPendingRequest(&_ISideChainManager.CallOpts, chainID, requestType)
 }
 
-// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+// GetPendingRequests is a free data retrieval call binding the contract method 0x9f01a25e.
 //
-// Solidity: function quitSideChain(uint64 chainID) returns()
-func (_ISideChainManager *ISideChainManagerSession) QuitSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.QuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getPendingRequests(uint8 requestType, uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerCaller) GetPendingRequests(opts *bind.CallOpts, requestType uint8, offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getPendingRequests", requestType, offset, limit)
+
+	if err != nil {
+		return *new([]ISideChainManagerSideChain), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]ISideChainManagerSideChain)).(*[]ISideChainManagerSideChain)
+
+	return out0, err
+
 }
 
-// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+// GetPendingRequests is a free data retrieval call binding the contract method 0x9f01a25e.
 //
-// Solidity: function quitSideChain(uint64 chainID) returns()
-func (_ISideChainManager *ISideChainManagerTransactorSession) QuitSideChain(chainID uint64) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.QuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+// Solidity: function getPendingRequests(uint8 requestType, uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerSession) GetPendingRequests(requestType uint8, offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetPendingRequests(&_ISideChainManager.CallOpts, requestType, offset, limit)
 }
 
-// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+// GetPendingRequests is a free data retrieval call binding the contract method 0x9f01a25e.
 //
-// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactor) RegisterAsset(opts *bind.TransactOpts, chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "registerAsset", chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+// Solidity: function getPendingRequests(uint8 requestType, uint64 offset, uint64 limit) view returns((address,uint64,uint64,string,bytes,bytes)[] sidechains)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetPendingRequests(requestType uint8, offset uint64, limit uint64) ([]ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetPendingRequests(&_ISideChainManager.CallOpts, requestType, offset, limit)
 }
 
-// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+// GetRequestBond is a free data retrieval call binding the contract method 0x50005b2a.
 //
-// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
-func (_ISideChainManager *ISideChainManagerSession) RegisterAsset(chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.RegisterAsset(&_ISideChainManager.TransactOpts, chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+// Solidity: function getRequestBond(uint64 chainID, uint8 requestType) view returns(address payer, uint256 amount)
+func (_ISideChainManager *ISideChainManagerCaller) GetRequestBond(opts *bind.CallOpts, chainID uint64, requestType uint8) (struct {
+	Payer  common.Address
+	Amount *big.Int
+}, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getRequestBond", chainID, requestType)
+
+	outstruct := new(struct {
+		Payer  common.Address
+		Amount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Payer = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
 }
 
-// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+// GetRequestBond is a free data retrieval call binding the contract method 0x50005b2a.
 //
-// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterAsset(chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.RegisterAsset(&_ISideChainManager.TransactOpts, chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+// Solidity: function getRequestBond(uint64 chainID, uint8 requestType) view returns(address payer, uint256 amount)
+func (_ISideChainManager *ISideChainManagerSession) GetRequestBond(chainID uint64, requestType uint8) (struct {
+	Payer  common.Address
+	Amount *big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetRequestBond(&_ISideChainManager.CallOpts, chainID, requestType)
 }
 
-// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
+// GetRequestBond is a free data retrieval call binding the contract method 0x50005b2a.
 //
-// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerTransactor) RegisterSideChain(opts *bind.TransactOpts, chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "registerSideChain", chainID, router, name, CCMCAddress, extraInfo)
+// Solidity: function getRequestBond(uint64 chainID, uint8 requestType) view returns(address payer, uint256 amount)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetRequestBond(chainID uint64, requestType uint8) (struct {
+	Payer  common.Address
+	Amount *big.Int
+}, error) {
+	return _ISideChainManager.Contract.GetRequestBond(&_ISideChainManager.CallOpts, chainID, requestType)
 }
 
-// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
+// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
 //
-// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerSession) RegisterSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.RegisterSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerCaller) GetSideChain(opts *bind.CallOpts, chainID uint64) (ISideChainManagerSideChain, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getSideChain", chainID)
+
+	if err != nil {
+		return *new(ISideChainManagerSideChain), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(ISideChainManagerSideChain)).(*ISideChainManagerSideChain)
+
+	return out0, err
+
+}
+
+// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
+//
+// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerSession) GetSideChain(chainID uint64) (ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetSideChain(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetSideChain is a free data retrieval call binding the contract method 0x84838fb8.
+//
+// Solidity: function getSideChain(uint64 chainID) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetSideChain(chainID uint64) (ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetSideChain(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetSideChainAtHeight is a free data retrieval call binding the contract method 0xf4df6709.
+//
+// Solidity: function getSideChainAtHeight(uint64 chainID, uint64 height) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerCaller) GetSideChainAtHeight(opts *bind.CallOpts, chainID uint64, height uint64) (ISideChainManagerSideChain, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getSideChainAtHeight", chainID, height)
+
+	if err != nil {
+		return *new(ISideChainManagerSideChain), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(ISideChainManagerSideChain)).(*ISideChainManagerSideChain)
+
+	return out0, err
+
+}
+
+// GetSideChainAtHeight is a free data retrieval call binding the contract method 0xf4df6709.
+//
+// Solidity: function getSideChainAtHeight(uint64 chainID, uint64 height) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerSession) GetSideChainAtHeight(chainID uint64, height uint64) (ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetSideChainAtHeight(&_ISideChainManager.CallOpts, chainID, height)
+}
+
+// GetSideChainAtHeight is a free data retrieval call binding the contract method 0xf4df6709.
+//
+// Solidity: function getSideChainAtHeight(uint64 chainID, uint64 height) view returns((address,uint64,uint64,string,bytes,bytes) sidechain)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetSideChainAtHeight(chainID uint64, height uint64) (ISideChainManagerSideChain, error) {
+	return _ISideChainManager.Contract.GetSideChainAtHeight(&_ISideChainManager.CallOpts, chainID, height)
+}
+
+// GetSideChainCount is a free data retrieval call binding the contract method 0x5f5711cc.
+//
+// Solidity: function getSideChainCount() view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerCaller) GetSideChainCount(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getSideChainCount")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetSideChainCount is a free data retrieval call binding the contract method 0x5f5711cc.
+//
+// Solidity: function getSideChainCount() view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerSession) GetSideChainCount() (uint64, error) {
+	return _ISideChainManager.Contract.GetSideChainCount(&_ISideChainManager.CallOpts)
+}
+
+// GetSideChainCount is a free data retrieval call binding the contract method 0x5f5711cc.
+//
+// Solidity: function getSideChainCount() view returns(uint64 count)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetSideChainCount() (uint64, error) {
+	return _ISideChainManager.Contract.GetSideChainCount(&_ISideChainManager.CallOpts)
+}
+
+// GetSideChainStatus is a free data retrieval call binding the contract method 0x68b68f88.
+//
+// Solidity: function getSideChainStatus(uint64 chainID) view returns(uint8 status)
+func (_ISideChainManager *ISideChainManagerCaller) GetSideChainStatus(opts *bind.CallOpts, chainID uint64) (uint8, error) {
+	var out []interface{}
+	err := _ISideChainManager.contract.Call(opts, &out, "getSideChainStatus", chainID)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GetSideChainStatus is a free data retrieval call binding the contract method 0x68b68f88.
+//
+// Solidity: function getSideChainStatus(uint64 chainID) view returns(uint8 status)
+func (_ISideChainManager *ISideChainManagerSession) GetSideChainStatus(chainID uint64) (uint8, error) {
+	return _ISideChainManager.Contract.GetSideChainStatus(&_ISideChainManager.CallOpts, chainID)
+}
+
+// GetSideChainStatus is a free data retrieval call binding the contract method 0x68b68f88.
+//
+// Solidity: function getSideChainStatus(uint64 chainID) view returns(uint8 status)
+func (_ISideChainManager *ISideChainManagerCallerSession) GetSideChainStatus(chainID uint64) (uint8, error) {
+	return _ISideChainManager.Contract.GetSideChainStatus(&_ISideChainManager.CallOpts, chainID)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0xe7ec4f2d.
+//
+// Solidity: function acceptOwnership(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) AcceptOwnership(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "acceptOwnership", chainID)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0xe7ec4f2d.
+//
+// Solidity: function acceptOwnership(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) AcceptOwnership(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.AcceptOwnership(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0xe7ec4f2d.
+//
+// Solidity: function acceptOwnership(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) AcceptOwnership(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.AcceptOwnership(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveAsset is a paid mutator transaction binding the contract method 0x2c9d31ce.
+//
+// Solidity: function approveAsset(uint64 chainID, bytes asset, string symbol, uint8 decimals, uint8 mode) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) ApproveAsset(opts *bind.TransactOpts, chainID uint64, asset []byte, symbol string, decimals uint8, mode uint8) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "approveAsset", chainID, asset, symbol, decimals, mode)
+}
+
+// ApproveAsset is a paid mutator transaction binding the contract method 0x2c9d31ce.
+//
+// Solidity: function approveAsset(uint64 chainID, bytes asset, string symbol, uint8 decimals, uint8 mode) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) ApproveAsset(chainID uint64, asset []byte, symbol string, decimals uint8, mode uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveAsset(&_ISideChainManager.TransactOpts, chainID, asset, symbol, decimals, mode)
+}
+
+// ApproveAsset is a paid mutator transaction binding the contract method 0x2c9d31ce.
+//
+// Solidity: function approveAsset(uint64 chainID, bytes asset, string symbol, uint8 decimals, uint8 mode) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveAsset(chainID uint64, asset []byte, symbol string, decimals uint8, mode uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveAsset(&_ISideChainManager.TransactOpts, chainID, asset, symbol, decimals, mode)
+}
+
+// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+//
+// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) ApproveQuitSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "approveQuitSideChain", chainID)
+}
+
+// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+//
+// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) ApproveQuitSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveQuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveQuitSideChain is a paid mutator transaction binding the contract method 0x9bcb64f0.
+//
+// Solidity: function approveQuitSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveQuitSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveQuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+//
+// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) ApproveRegisterSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "approveRegisterSideChain", chainID)
+}
+
+// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+//
+// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) ApproveRegisterSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveRegisterSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveRegisterSideChain is a paid mutator transaction binding the contract method 0xc3e7746d.
+//
+// Solidity: function approveRegisterSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveRegisterSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveRegisterSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+//
+// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) ApproveUpdateSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "approveUpdateSideChain", chainID)
+}
+
+// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+//
+// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) ApproveUpdateSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveUpdateSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// ApproveUpdateSideChain is a paid mutator transaction binding the contract method 0x678f0135.
+//
+// Solidity: function approveUpdateSideChain(uint64 chainID) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) ApproveUpdateSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.ApproveUpdateSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// CancelRequest is a paid mutator transaction binding the contract method 0x11f703db.
+//
+// Solidity: function cancelRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) CancelRequest(opts *bind.TransactOpts, chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "cancelRequest", chainID, requestType)
+}
+
+// CancelRequest is a paid mutator transaction binding the contract method 0x11f703db.
+//
+// Solidity: function cancelRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) CancelRequest(chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.CancelRequest(&_ISideChainManager.TransactOpts, chainID, requestType)
+}
+
+// CancelRequest is a paid mutator transaction binding the contract method 0x11f703db.
+//
+// Solidity: function cancelRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) CancelRequest(chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.CancelRequest(&_ISideChainManager.TransactOpts, chainID, requestType)
+}
+
+// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+//
+// Solidity: function quitSideChain(uint64 chainID) returns()
+func (_ISideChainManager *ISideChainManagerTransactor) QuitSideChain(opts *bind.TransactOpts, chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "quitSideChain", chainID)
+}
+
+// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+//
+// Solidity: function quitSideChain(uint64 chainID) returns()
+func (_ISideChainManager *ISideChainManagerSession) QuitSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.QuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// QuitSideChain is a paid mutator transaction binding the contract method 0x78b94ab1.
+//
+// Solidity: function quitSideChain(uint64 chainID) returns()
+func (_ISideChainManager *ISideChainManagerTransactorSession) QuitSideChain(chainID uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.QuitSideChain(&_ISideChainManager.TransactOpts, chainID)
+}
+
+// RecoverOwnership is a paid mutator transaction binding the contract method 0xa8d849c4.
+//
+// Solidity: function recoverOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) RecoverOwnership(opts *bind.TransactOpts, chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "recoverOwnership", chainID, newOwner)
+}
+
+// RecoverOwnership is a paid mutator transaction binding the contract method 0xa8d849c4.
+//
+// Solidity: function recoverOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) RecoverOwnership(chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RecoverOwnership(&_ISideChainManager.TransactOpts, chainID, newOwner)
+}
+
+// RecoverOwnership is a paid mutator transaction binding the contract method 0xa8d849c4.
+//
+// Solidity: function recoverOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) RecoverOwnership(chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RecoverOwnership(&_ISideChainManager.TransactOpts, chainID, newOwner)
+}
+
+// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+//
+// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) RegisterAsset(opts *bind.TransactOpts, chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "registerAsset", chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+}
+
+// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+//
+// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) RegisterAsset(chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterAsset(&_ISideChainManager.TransactOpts, chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+}
+
+// RegisterAsset is a paid mutator transaction binding the contract method 0xe171240f.
+//
+// Solidity: function registerAsset(uint64 chainID, uint64[] AssetMapKey, bytes[] AssetMapValue, uint64[] LockProxyMapKey, bytes[] LockProxyMapValue) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterAsset(chainID uint64, AssetMapKey []uint64, AssetMapValue [][]byte, LockProxyMapKey []uint64, LockProxyMapValue [][]byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterAsset(&_ISideChainManager.TransactOpts, chainID, AssetMapKey, AssetMapValue, LockProxyMapKey, LockProxyMapValue)
+}
+
+// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
+//
+// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) payable returns()
+func (_ISideChainManager *ISideChainManagerTransactor) RegisterSideChain(opts *bind.TransactOpts, chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "registerSideChain", chainID, router, name, CCMCAddress, extraInfo)
+}
+
+// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
+//
+// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) payable returns()
+func (_ISideChainManager *ISideChainManagerSession) RegisterSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+}
+
+// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
+//
+// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) payable returns()
+func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RegisterSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+}
+
+// RejectRequest is a paid mutator transaction binding the contract method 0xb29b5387.
+//
+// Solidity: function rejectRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) RejectRequest(opts *bind.TransactOpts, chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "rejectRequest", chainID, requestType)
+}
+
+// RejectRequest is a paid mutator transaction binding the contract method 0xb29b5387.
+//
+// Solidity: function rejectRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) RejectRequest(chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RejectRequest(&_ISideChainManager.TransactOpts, chainID, requestType)
+}
+
+// RejectRequest is a paid mutator transaction binding the contract method 0xb29b5387.
+//
+// Solidity: function rejectRequest(uint64 chainID, uint8 requestType) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) RejectRequest(chainID uint64, requestType uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RejectRequest(&_ISideChainManager.TransactOpts, chainID, requestType)
+}
+
+// RemoveAsset is a paid mutator transaction binding the contract method 0xed3e9ab7.
+//
+// Solidity: function removeAsset(uint64 chainID, bytes asset) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) RemoveAsset(opts *bind.TransactOpts, chainID uint64, asset []byte) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "removeAsset", chainID, asset)
+}
+
+// RemoveAsset is a paid mutator transaction binding the contract method 0xed3e9ab7.
+//
+// Solidity: function removeAsset(uint64 chainID, bytes asset) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) RemoveAsset(chainID uint64, asset []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RemoveAsset(&_ISideChainManager.TransactOpts, chainID, asset)
+}
+
+// RemoveAsset is a paid mutator transaction binding the contract method 0xed3e9ab7.
+//
+// Solidity: function removeAsset(uint64 chainID, bytes asset) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) RemoveAsset(chainID uint64, asset []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.RemoveAsset(&_ISideChainManager.TransactOpts, chainID, asset)
+}
+
+// SetBondParams is a paid mutator transaction binding the contract method 0xe86c95c8.
+//
+// Solidity: function setBondParams(uint256 registerBond, uint256 updateBond) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) SetBondParams(opts *bind.TransactOpts, registerBond *big.Int, updateBond *big.Int) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "setBondParams", registerBond, updateBond)
+}
+
+// SetBondParams is a paid mutator transaction binding the contract method 0xe86c95c8.
+//
+// Solidity: function setBondParams(uint256 registerBond, uint256 updateBond) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) SetBondParams(registerBond *big.Int, updateBond *big.Int) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetBondParams(&_ISideChainManager.TransactOpts, registerBond, updateBond)
+}
+
+// SetBondParams is a paid mutator transaction binding the contract method 0xe86c95c8.
+//
+// Solidity: function setBondParams(uint256 registerBond, uint256 updateBond) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) SetBondParams(registerBond *big.Int, updateBond *big.Int) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetBondParams(&_ISideChainManager.TransactOpts, registerBond, updateBond)
+}
+
+// SetFeeParams is a paid mutator transaction binding the contract method 0xef2c9bcb.
+//
+// Solidity: function setFeeParams(uint64 chainID, uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) SetFeeParams(opts *bind.TransactOpts, chainID uint64, multiplier uint64, minFee *big.Int, maxFee *big.Int, timeout uint64, stakeWeighted bool) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "setFeeParams", chainID, multiplier, minFee, maxFee, timeout, stakeWeighted)
+}
+
+// SetFeeParams is a paid mutator transaction binding the contract method 0xef2c9bcb.
+//
+// Solidity: function setFeeParams(uint64 chainID, uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) SetFeeParams(chainID uint64, multiplier uint64, minFee *big.Int, maxFee *big.Int, timeout uint64, stakeWeighted bool) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetFeeParams(&_ISideChainManager.TransactOpts, chainID, multiplier, minFee, maxFee, timeout, stakeWeighted)
+}
+
+// SetFeeParams is a paid mutator transaction binding the contract method 0xef2c9bcb.
+//
+// Solidity: function setFeeParams(uint64 chainID, uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) SetFeeParams(chainID uint64, multiplier uint64, minFee *big.Int, maxFee *big.Int, timeout uint64, stakeWeighted bool) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetFeeParams(&_ISideChainManager.TransactOpts, chainID, multiplier, minFee, maxFee, timeout, stakeWeighted)
+}
+
+// SetSideChainStatus is a paid mutator transaction binding the contract method 0x58161f7f.
+//
+// Solidity: function setSideChainStatus(uint64 chainID, uint8 status) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) SetSideChainStatus(opts *bind.TransactOpts, chainID uint64, status uint8) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "setSideChainStatus", chainID, status)
+}
+
+// SetSideChainStatus is a paid mutator transaction binding the contract method 0x58161f7f.
+//
+// Solidity: function setSideChainStatus(uint64 chainID, uint8 status) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) SetSideChainStatus(chainID uint64, status uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetSideChainStatus(&_ISideChainManager.TransactOpts, chainID, status)
+}
+
+// SetSideChainStatus is a paid mutator transaction binding the contract method 0x58161f7f.
+//
+// Solidity: function setSideChainStatus(uint64 chainID, uint8 status) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) SetSideChainStatus(chainID uint64, status uint8) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.SetSideChainStatus(&_ISideChainManager.TransactOpts, chainID, status)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0x0a94864e.
+//
+// Solidity: function transferOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) TransferOwnership(opts *bind.TransactOpts, chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "transferOwnership", chainID, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0x0a94864e.
+//
+// Solidity: function transferOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) TransferOwnership(chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.TransferOwnership(&_ISideChainManager.TransactOpts, chainID, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0x0a94864e.
+//
+// Solidity: function transferOwnership(uint64 chainID, address newOwner) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) TransferOwnership(chainID uint64, newOwner common.Address) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.TransferOwnership(&_ISideChainManager.TransactOpts, chainID, newOwner)
+}
+
+// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
+//
+// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactor) UpdateFee(opts *bind.TransactOpts, chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "updateFee", chainID, viewNum, fee, signature)
+}
+
+// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
+//
+// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
+func (_ISideChainManager *ISideChainManagerSession) UpdateFee(chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateFee(&_ISideChainManager.TransactOpts, chainID, viewNum, fee, signature)
+}
+
+// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
+//
+// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
+func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateFee(chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateFee(&_ISideChainManager.TransactOpts, chainID, viewNum, fee, signature)
+}
+
+// UpdateSideChain is a paid mutator transaction binding the contract method 0xea5fd28b.
+//
+// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo, uint64 activationHeight) payable returns()
+func (_ISideChainManager *ISideChainManagerTransactor) UpdateSideChain(opts *bind.TransactOpts, chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte, activationHeight uint64) (*types.Transaction, error) {
+	return _ISideChainManager.contract.Transact(opts, "updateSideChain", chainID, router, name, CCMCAddress, extraInfo, activationHeight)
+}
+
+// UpdateSideChain is a paid mutator transaction binding the contract method 0xea5fd28b.
+//
+// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo, uint64 activationHeight) payable returns()
+func (_ISideChainManager *ISideChainManagerSession) UpdateSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte, activationHeight uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo, activationHeight)
+}
+
+// UpdateSideChain is a paid mutator transaction binding the contract method 0xea5fd28b.
+//
+// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo, uint64 activationHeight) payable returns()
+func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte, activationHeight uint64) (*types.Transaction, error) {
+	return _ISideChainManager.Contract.UpdateSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo, activationHeight)
+}
+
+// ISideChainManagerApproveQuitSideChainIterator is returned from FilterApproveQuitSideChain and is used to iterate over the raw logs and unpacked data for ApproveQuitSideChain events raised by the ISideChainManager contract.
+type ISideChainManagerApproveQuitSideChainIterator struct {
+	Event *ISideChainManagerApproveQuitSideChain // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerApproveQuitSideChainIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerApproveQuitSideChain)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerApproveQuitSideChain)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerApproveQuitSideChainIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerApproveQuitSideChainIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerApproveQuitSideChain represents a ApproveQuitSideChain event raised by the ISideChainManager contract.
+type ISideChainManagerApproveQuitSideChain struct {
+	ChainId uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproveQuitSideChain is a free log retrieval operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+//
+// Solidity: event ApproveQuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveQuitSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveQuitSideChainIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveQuitSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerApproveQuitSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveQuitSideChain", logs: logs, sub: sub}, nil
+}
+
+// WatchApproveQuitSideChain is a free log subscription operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+//
+// Solidity: event ApproveQuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveQuitSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveQuitSideChain) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveQuitSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerApproveQuitSideChain)
+				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveQuitSideChain", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproveQuitSideChain is a log parse operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+//
+// Solidity: event ApproveQuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveQuitSideChain(log types.Log) (*ISideChainManagerApproveQuitSideChain, error) {
+	event := new(ISideChainManagerApproveQuitSideChain)
+	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveQuitSideChain", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerApproveRegisterSideChainIterator is returned from FilterApproveRegisterSideChain and is used to iterate over the raw logs and unpacked data for ApproveRegisterSideChain events raised by the ISideChainManager contract.
+type ISideChainManagerApproveRegisterSideChainIterator struct {
+	Event *ISideChainManagerApproveRegisterSideChain // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerApproveRegisterSideChainIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerApproveRegisterSideChain)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerApproveRegisterSideChain)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerApproveRegisterSideChainIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerApproveRegisterSideChainIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerApproveRegisterSideChain represents a ApproveRegisterSideChain event raised by the ISideChainManager contract.
+type ISideChainManagerApproveRegisterSideChain struct {
+	ChainId uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproveRegisterSideChain is a free log retrieval operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+//
+// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveRegisterSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveRegisterSideChainIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveRegisterSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerApproveRegisterSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveRegisterSideChain", logs: logs, sub: sub}, nil
+}
+
+// WatchApproveRegisterSideChain is a free log subscription operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+//
+// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveRegisterSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveRegisterSideChain) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveRegisterSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerApproveRegisterSideChain)
+				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveRegisterSideChain", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproveRegisterSideChain is a log parse operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+//
+// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveRegisterSideChain(log types.Log) (*ISideChainManagerApproveRegisterSideChain, error) {
+	event := new(ISideChainManagerApproveRegisterSideChain)
+	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveRegisterSideChain", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerApproveUpdateSideChainIterator is returned from FilterApproveUpdateSideChain and is used to iterate over the raw logs and unpacked data for ApproveUpdateSideChain events raised by the ISideChainManager contract.
+type ISideChainManagerApproveUpdateSideChainIterator struct {
+	Event *ISideChainManagerApproveUpdateSideChain // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerApproveUpdateSideChainIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerApproveUpdateSideChain)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerApproveUpdateSideChain)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerApproveUpdateSideChainIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerApproveUpdateSideChainIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerApproveUpdateSideChain represents a ApproveUpdateSideChain event raised by the ISideChainManager contract.
+type ISideChainManagerApproveUpdateSideChain struct {
+	ChainId uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproveUpdateSideChain is a free log retrieval operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+//
+// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveUpdateSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveUpdateSideChainIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveUpdateSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerApproveUpdateSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveUpdateSideChain", logs: logs, sub: sub}, nil
+}
+
+// WatchApproveUpdateSideChain is a free log subscription operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+//
+// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveUpdateSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveUpdateSideChain) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveUpdateSideChain")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerApproveUpdateSideChain)
+				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveUpdateSideChain", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproveUpdateSideChain is a log parse operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+//
+// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveUpdateSideChain(log types.Log) (*ISideChainManagerApproveUpdateSideChain, error) {
+	event := new(ISideChainManagerApproveUpdateSideChain)
+	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveUpdateSideChain", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerAssetApprovedIterator is returned from FilterAssetApproved and is used to iterate over the raw logs and unpacked data for AssetApproved events raised by the ISideChainManager contract.
+type ISideChainManagerAssetApprovedIterator struct {
+	Event *ISideChainManagerAssetApproved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerAssetApprovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerAssetApproved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerAssetApproved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerAssetApprovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerAssetApprovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerAssetApproved represents a AssetApproved event raised by the ISideChainManager contract.
+type ISideChainManagerAssetApproved struct {
+	ChainId  uint64
+	Asset    []byte
+	Symbol   string
+	Decimals uint8
+	Mode     uint8
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterAssetApproved is a free log retrieval operation binding the contract event 0xe4475175960f477e3e57aaf947acd7fe7d38250b1de85f1a5f2f3d177ddf3f66.
+//
+// Solidity: event AssetApproved(uint64 ChainId, bytes Asset, string Symbol, uint8 Decimals, uint8 Mode)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterAssetApproved(opts *bind.FilterOpts) (*ISideChainManagerAssetApprovedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "AssetApproved")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerAssetApprovedIterator{contract: _ISideChainManager.contract, event: "AssetApproved", logs: logs, sub: sub}, nil
+}
+
+// WatchAssetApproved is a free log subscription operation binding the contract event 0xe4475175960f477e3e57aaf947acd7fe7d38250b1de85f1a5f2f3d177ddf3f66.
+//
+// Solidity: event AssetApproved(uint64 ChainId, bytes Asset, string Symbol, uint8 Decimals, uint8 Mode)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchAssetApproved(opts *bind.WatchOpts, sink chan<- *ISideChainManagerAssetApproved) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "AssetApproved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerAssetApproved)
+				if err := _ISideChainManager.contract.UnpackLog(event, "AssetApproved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAssetApproved is a log parse operation binding the contract event 0xe4475175960f477e3e57aaf947acd7fe7d38250b1de85f1a5f2f3d177ddf3f66.
+//
+// Solidity: event AssetApproved(uint64 ChainId, bytes Asset, string Symbol, uint8 Decimals, uint8 Mode)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseAssetApproved(log types.Log) (*ISideChainManagerAssetApproved, error) {
+	event := new(ISideChainManagerAssetApproved)
+	if err := _ISideChainManager.contract.UnpackLog(event, "AssetApproved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerAssetRemovedIterator is returned from FilterAssetRemoved and is used to iterate over the raw logs and unpacked data for AssetRemoved events raised by the ISideChainManager contract.
+type ISideChainManagerAssetRemovedIterator struct {
+	Event *ISideChainManagerAssetRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerAssetRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerAssetRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerAssetRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerAssetRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerAssetRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerAssetRemoved represents a AssetRemoved event raised by the ISideChainManager contract.
+type ISideChainManagerAssetRemoved struct {
+	ChainId uint64
+	Asset   []byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAssetRemoved is a free log retrieval operation binding the contract event 0xe6abef069b78face4d2126c69859883dc16192ac528ea104034e302585cf9457.
+//
+// Solidity: event AssetRemoved(uint64 ChainId, bytes Asset)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterAssetRemoved(opts *bind.FilterOpts) (*ISideChainManagerAssetRemovedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "AssetRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerAssetRemovedIterator{contract: _ISideChainManager.contract, event: "AssetRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchAssetRemoved is a free log subscription operation binding the contract event 0xe6abef069b78face4d2126c69859883dc16192ac528ea104034e302585cf9457.
+//
+// Solidity: event AssetRemoved(uint64 ChainId, bytes Asset)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchAssetRemoved(opts *bind.WatchOpts, sink chan<- *ISideChainManagerAssetRemoved) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "AssetRemoved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerAssetRemoved)
+				if err := _ISideChainManager.contract.UnpackLog(event, "AssetRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAssetRemoved is a log parse operation binding the contract event 0xe6abef069b78face4d2126c69859883dc16192ac528ea104034e302585cf9457.
+//
+// Solidity: event AssetRemoved(uint64 ChainId, bytes Asset)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseAssetRemoved(log types.Log) (*ISideChainManagerAssetRemoved, error) {
+	event := new(ISideChainManagerAssetRemoved)
+	if err := _ISideChainManager.contract.UnpackLog(event, "AssetRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerBondForfeitedIterator is returned from FilterBondForfeited and is used to iterate over the raw logs and unpacked data for BondForfeited events raised by the ISideChainManager contract.
+type ISideChainManagerBondForfeitedIterator struct {
+	Event *ISideChainManagerBondForfeited // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerBondForfeitedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerBondForfeited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerBondForfeited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerBondForfeitedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerBondForfeitedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerBondForfeited represents a BondForfeited event raised by the ISideChainManager contract.
+type ISideChainManagerBondForfeited struct {
+	ChainId     uint64
+	RequestType uint8
+	Payer       common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondForfeited is a free log retrieval operation binding the contract event 0xfa3b675f7567ae89691af4163632df2d6b6389c8f5e979923b4286367947e3e0.
+//
+// Solidity: event BondForfeited(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterBondForfeited(opts *bind.FilterOpts) (*ISideChainManagerBondForfeitedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "BondForfeited")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerBondForfeitedIterator{contract: _ISideChainManager.contract, event: "BondForfeited", logs: logs, sub: sub}, nil
+}
+
+// WatchBondForfeited is a free log subscription operation binding the contract event 0xfa3b675f7567ae89691af4163632df2d6b6389c8f5e979923b4286367947e3e0.
+//
+// Solidity: event BondForfeited(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchBondForfeited(opts *bind.WatchOpts, sink chan<- *ISideChainManagerBondForfeited) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "BondForfeited")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerBondForfeited)
+				if err := _ISideChainManager.contract.UnpackLog(event, "BondForfeited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondForfeited is a log parse operation binding the contract event 0xfa3b675f7567ae89691af4163632df2d6b6389c8f5e979923b4286367947e3e0.
+//
+// Solidity: event BondForfeited(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseBondForfeited(log types.Log) (*ISideChainManagerBondForfeited, error) {
+	event := new(ISideChainManagerBondForfeited)
+	if err := _ISideChainManager.contract.UnpackLog(event, "BondForfeited", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerBondParamsUpdatedIterator is returned from FilterBondParamsUpdated and is used to iterate over the raw logs and unpacked data for BondParamsUpdated events raised by the ISideChainManager contract.
+type ISideChainManagerBondParamsUpdatedIterator struct {
+	Event *ISideChainManagerBondParamsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerBondParamsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerBondParamsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerBondParamsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerBondParamsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerBondParamsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerBondParamsUpdated represents a BondParamsUpdated event raised by the ISideChainManager contract.
+type ISideChainManagerBondParamsUpdated struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondParamsUpdated is a free log retrieval operation binding the contract event 0x7e8024c10e79076fc983395ae5e281756a6dd941b2f596a47b4131be679f8f68.
+//
+// Solidity: event BondParamsUpdated(uint256 RegisterBond, uint256 UpdateBond)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterBondParamsUpdated(opts *bind.FilterOpts) (*ISideChainManagerBondParamsUpdatedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "BondParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerBondParamsUpdatedIterator{contract: _ISideChainManager.contract, event: "BondParamsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBondParamsUpdated is a free log subscription operation binding the contract event 0x7e8024c10e79076fc983395ae5e281756a6dd941b2f596a47b4131be679f8f68.
+//
+// Solidity: event BondParamsUpdated(uint256 RegisterBond, uint256 UpdateBond)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchBondParamsUpdated(opts *bind.WatchOpts, sink chan<- *ISideChainManagerBondParamsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "BondParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerBondParamsUpdated)
+				if err := _ISideChainManager.contract.UnpackLog(event, "BondParamsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondParamsUpdated is a log parse operation binding the contract event 0x7e8024c10e79076fc983395ae5e281756a6dd941b2f596a47b4131be679f8f68.
+//
+// Solidity: event BondParamsUpdated(uint256 RegisterBond, uint256 UpdateBond)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseBondParamsUpdated(log types.Log) (*ISideChainManagerBondParamsUpdated, error) {
+	event := new(ISideChainManagerBondParamsUpdated)
+	if err := _ISideChainManager.contract.UnpackLog(event, "BondParamsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerBondRefundedIterator is returned from FilterBondRefunded and is used to iterate over the raw logs and unpacked data for BondRefunded events raised by the ISideChainManager contract.
+type ISideChainManagerBondRefundedIterator struct {
+	Event *ISideChainManagerBondRefunded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerBondRefundedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerBondRefunded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerBondRefunded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerBondRefundedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerBondRefundedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerBondRefunded represents a BondRefunded event raised by the ISideChainManager contract.
+type ISideChainManagerBondRefunded struct {
+	ChainId     uint64
+	RequestType uint8
+	Payer       common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBondRefunded is a free log retrieval operation binding the contract event 0xadae17ebb64e2c2ed5550d305bb979a7aac2709119087c0bc45d02a5f5e936cc.
+//
+// Solidity: event BondRefunded(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterBondRefunded(opts *bind.FilterOpts) (*ISideChainManagerBondRefundedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "BondRefunded")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerBondRefundedIterator{contract: _ISideChainManager.contract, event: "BondRefunded", logs: logs, sub: sub}, nil
+}
+
+// WatchBondRefunded is a free log subscription operation binding the contract event 0xadae17ebb64e2c2ed5550d305bb979a7aac2709119087c0bc45d02a5f5e936cc.
+//
+// Solidity: event BondRefunded(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchBondRefunded(opts *bind.WatchOpts, sink chan<- *ISideChainManagerBondRefunded) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "BondRefunded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerBondRefunded)
+				if err := _ISideChainManager.contract.UnpackLog(event, "BondRefunded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBondRefunded is a log parse operation binding the contract event 0xadae17ebb64e2c2ed5550d305bb979a7aac2709119087c0bc45d02a5f5e936cc.
+//
+// Solidity: event BondRefunded(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseBondRefunded(log types.Log) (*ISideChainManagerBondRefunded, error) {
+	event := new(ISideChainManagerBondRefunded)
+	if err := _ISideChainManager.contract.UnpackLog(event, "BondRefunded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerCancelRequestIterator is returned from FilterCancelRequest and is used to iterate over the raw logs and unpacked data for CancelRequest events raised by the ISideChainManager contract.
+type ISideChainManagerCancelRequestIterator struct {
+	Event *ISideChainManagerCancelRequest // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerCancelRequestIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerCancelRequest)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerCancelRequest)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerCancelRequestIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerCancelRequestIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerCancelRequest represents a CancelRequest event raised by the ISideChainManager contract.
+type ISideChainManagerCancelRequest struct {
+	ChainId     uint64
+	RequestType uint8
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterCancelRequest is a free log retrieval operation binding the contract event 0xbddb0608885e7880b9237726eabb575723597aa909bf9c1636fe2639bb0d35e5.
+//
+// Solidity: event CancelRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterCancelRequest(opts *bind.FilterOpts) (*ISideChainManagerCancelRequestIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "CancelRequest")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerCancelRequestIterator{contract: _ISideChainManager.contract, event: "CancelRequest", logs: logs, sub: sub}, nil
+}
+
+// WatchCancelRequest is a free log subscription operation binding the contract event 0xbddb0608885e7880b9237726eabb575723597aa909bf9c1636fe2639bb0d35e5.
+//
+// Solidity: event CancelRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchCancelRequest(opts *bind.WatchOpts, sink chan<- *ISideChainManagerCancelRequest) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "CancelRequest")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerCancelRequest)
+				if err := _ISideChainManager.contract.UnpackLog(event, "CancelRequest", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCancelRequest is a log parse operation binding the contract event 0xbddb0608885e7880b9237726eabb575723597aa909bf9c1636fe2639bb0d35e5.
+//
+// Solidity: event CancelRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseCancelRequest(log types.Log) (*ISideChainManagerCancelRequest, error) {
+	event := new(ISideChainManagerCancelRequest)
+	if err := _ISideChainManager.contract.UnpackLog(event, "CancelRequest", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerFeeParamsUpdatedIterator is returned from FilterFeeParamsUpdated and is used to iterate over the raw logs and unpacked data for FeeParamsUpdated events raised by the ISideChainManager contract.
+type ISideChainManagerFeeParamsUpdatedIterator struct {
+	Event *ISideChainManagerFeeParamsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerFeeParamsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerFeeParamsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerFeeParamsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerFeeParamsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerFeeParamsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerFeeParamsUpdated represents a FeeParamsUpdated event raised by the ISideChainManager contract.
+type ISideChainManagerFeeParamsUpdated struct {
+	ChainId       uint64
+	Multiplier    uint64
+	MinFee        *big.Int
+	MaxFee        *big.Int
+	Timeout       uint64
+	StakeWeighted bool
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeParamsUpdated is a free log retrieval operation binding the contract event 0x6042ae2a4e33e4a38b7f1dcca548df68c54ac1e71b45078877773cc070f2df9e.
+//
+// Solidity: event FeeParamsUpdated(uint64 ChainId, uint64 Multiplier, uint256 MinFee, uint256 MaxFee, uint64 Timeout, bool StakeWeighted)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterFeeParamsUpdated(opts *bind.FilterOpts) (*ISideChainManagerFeeParamsUpdatedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "FeeParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerFeeParamsUpdatedIterator{contract: _ISideChainManager.contract, event: "FeeParamsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeParamsUpdated is a free log subscription operation binding the contract event 0x6042ae2a4e33e4a38b7f1dcca548df68c54ac1e71b45078877773cc070f2df9e.
+//
+// Solidity: event FeeParamsUpdated(uint64 ChainId, uint64 Multiplier, uint256 MinFee, uint256 MaxFee, uint64 Timeout, bool StakeWeighted)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchFeeParamsUpdated(opts *bind.WatchOpts, sink chan<- *ISideChainManagerFeeParamsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "FeeParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerFeeParamsUpdated)
+				if err := _ISideChainManager.contract.UnpackLog(event, "FeeParamsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeParamsUpdated is a log parse operation binding the contract event 0x6042ae2a4e33e4a38b7f1dcca548df68c54ac1e71b45078877773cc070f2df9e.
+//
+// Solidity: event FeeParamsUpdated(uint64 ChainId, uint64 Multiplier, uint256 MinFee, uint256 MaxFee, uint64 Timeout, bool StakeWeighted)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseFeeParamsUpdated(log types.Log) (*ISideChainManagerFeeParamsUpdated, error) {
+	event := new(ISideChainManagerFeeParamsUpdated)
+	if err := _ISideChainManager.contract.UnpackLog(event, "FeeParamsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerFeeUpdatedIterator is returned from FilterFeeUpdated and is used to iterate over the raw logs and unpacked data for FeeUpdated events raised by the ISideChainManager contract.
+type ISideChainManagerFeeUpdatedIterator struct {
+	Event *ISideChainManagerFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerFeeUpdated represents a FeeUpdated event raised by the ISideChainManager contract.
+type ISideChainManagerFeeUpdated struct {
+	ChainId uint64
+	View    uint64
+	Fee     *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeUpdated is a free log retrieval operation binding the contract event 0xe83fa74693175dd95d2a528c0d1a09e68dccc05b15b3993f691ae9ffb12703e5.
+//
+// Solidity: event FeeUpdated(uint64 ChainId, uint64 View, uint256 Fee)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterFeeUpdated(opts *bind.FilterOpts) (*ISideChainManagerFeeUpdatedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "FeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerFeeUpdatedIterator{contract: _ISideChainManager.contract, event: "FeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeUpdated is a free log subscription operation binding the contract event 0xe83fa74693175dd95d2a528c0d1a09e68dccc05b15b3993f691ae9ffb12703e5.
+//
+// Solidity: event FeeUpdated(uint64 ChainId, uint64 View, uint256 Fee)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchFeeUpdated(opts *bind.WatchOpts, sink chan<- *ISideChainManagerFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "FeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerFeeUpdated)
+				if err := _ISideChainManager.contract.UnpackLog(event, "FeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeUpdated is a log parse operation binding the contract event 0xe83fa74693175dd95d2a528c0d1a09e68dccc05b15b3993f691ae9ffb12703e5.
+//
+// Solidity: event FeeUpdated(uint64 ChainId, uint64 View, uint256 Fee)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseFeeUpdated(log types.Log) (*ISideChainManagerFeeUpdated, error) {
+	event := new(ISideChainManagerFeeUpdated)
+	if err := _ISideChainManager.contract.UnpackLog(event, "FeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerOwnershipRecoveredIterator is returned from FilterOwnershipRecovered and is used to iterate over the raw logs and unpacked data for OwnershipRecovered events raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipRecoveredIterator struct {
+	Event *ISideChainManagerOwnershipRecovered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerOwnershipRecoveredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerOwnershipRecovered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerOwnershipRecovered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerOwnershipRecoveredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerOwnershipRecoveredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerOwnershipRecovered represents a OwnershipRecovered event raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipRecovered struct {
+	ChainId       uint64
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipRecovered is a free log retrieval operation binding the contract event 0x4477ab624f703eb80910651489453df0dfe5d691d4488cc41358183887cb4539.
+//
+// Solidity: event OwnershipRecovered(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterOwnershipRecovered(opts *bind.FilterOpts) (*ISideChainManagerOwnershipRecoveredIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "OwnershipRecovered")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerOwnershipRecoveredIterator{contract: _ISideChainManager.contract, event: "OwnershipRecovered", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipRecovered is a free log subscription operation binding the contract event 0x4477ab624f703eb80910651489453df0dfe5d691d4488cc41358183887cb4539.
+//
+// Solidity: event OwnershipRecovered(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchOwnershipRecovered(opts *bind.WatchOpts, sink chan<- *ISideChainManagerOwnershipRecovered) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "OwnershipRecovered")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerOwnershipRecovered)
+				if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipRecovered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipRecovered is a log parse operation binding the contract event 0x4477ab624f703eb80910651489453df0dfe5d691d4488cc41358183887cb4539.
+//
+// Solidity: event OwnershipRecovered(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseOwnershipRecovered(log types.Log) (*ISideChainManagerOwnershipRecovered, error) {
+	event := new(ISideChainManagerOwnershipRecovered)
+	if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipRecovered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerOwnershipTransferStartedIterator is returned from FilterOwnershipTransferStarted and is used to iterate over the raw logs and unpacked data for OwnershipTransferStarted events raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipTransferStartedIterator struct {
+	Event *ISideChainManagerOwnershipTransferStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerOwnershipTransferStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerOwnershipTransferStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerOwnershipTransferStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerOwnershipTransferStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerOwnershipTransferStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ISideChainManagerOwnershipTransferStarted represents a OwnershipTransferStarted event raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipTransferStarted struct {
+	ChainId  uint64
+	Owner    common.Address
+	NewOwner common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferStarted is a free log retrieval operation binding the contract event 0xccd22395286739206104fb65ed125ee90c51079e8438f624e5774a32dcca42a1.
+//
+// Solidity: event OwnershipTransferStarted(uint64 ChainId, address Owner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterOwnershipTransferStarted(opts *bind.FilterOpts) (*ISideChainManagerOwnershipTransferStartedIterator, error) {
+
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "OwnershipTransferStarted")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerOwnershipTransferStartedIterator{contract: _ISideChainManager.contract, event: "OwnershipTransferStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferStarted is a free log subscription operation binding the contract event 0xccd22395286739206104fb65ed125ee90c51079e8438f624e5774a32dcca42a1.
+//
+// Solidity: event OwnershipTransferStarted(uint64 ChainId, address Owner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchOwnershipTransferStarted(opts *bind.WatchOpts, sink chan<- *ISideChainManagerOwnershipTransferStarted) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "OwnershipTransferStarted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerOwnershipTransferStarted)
+				if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferStarted is a log parse operation binding the contract event 0xccd22395286739206104fb65ed125ee90c51079e8438f624e5774a32dcca42a1.
+//
+// Solidity: event OwnershipTransferStarted(uint64 ChainId, address Owner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseOwnershipTransferStarted(log types.Log) (*ISideChainManagerOwnershipTransferStarted, error) {
+	event := new(ISideChainManagerOwnershipTransferStarted)
+	if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ISideChainManagerOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipTransferredIterator struct {
+	Event *ISideChainManagerOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ISideChainManagerOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ISideChainManagerOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ISideChainManagerOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// RegisterSideChain is a paid mutator transaction binding the contract method 0x3a24101f.
-//
-// Solidity: function registerSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerTransactorSession) RegisterSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.RegisterSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ISideChainManagerOwnershipTransferredIterator) Error() error {
+	return it.fail
 }
 
-// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
-//
-// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactor) UpdateFee(opts *bind.TransactOpts, chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "updateFee", chainID, viewNum, fee, signature)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ISideChainManagerOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
-//
-// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
-func (_ISideChainManager *ISideChainManagerSession) UpdateFee(chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.UpdateFee(&_ISideChainManager.TransactOpts, chainID, viewNum, fee, signature)
+// ISideChainManagerOwnershipTransferred represents a OwnershipTransferred event raised by the ISideChainManager contract.
+type ISideChainManagerOwnershipTransferred struct {
+	ChainId       uint64
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
 }
 
-// UpdateFee is a paid mutator transaction binding the contract method 0xdb5d3488.
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x5bef236c3d8ca4802fed22bae661560c9d4f6e9c79b438d14c05b1fe9190b6a7.
 //
-// Solidity: function updateFee(uint64 chainID, uint64 viewNum, int256 fee, bytes signature) returns(bool success)
-func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateFee(chainID uint64, viewNum uint64, fee *big.Int, signature []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.UpdateFee(&_ISideChainManager.TransactOpts, chainID, viewNum, fee, signature)
-}
+// Solidity: event OwnershipTransferred(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts) (*ISideChainManagerOwnershipTransferredIterator, error) {
 
-// UpdateSideChain is a paid mutator transaction binding the contract method 0x956f1463.
-//
-// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerTransactor) UpdateSideChain(opts *bind.TransactOpts, chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.contract.Transact(opts, "updateSideChain", chainID, router, name, CCMCAddress, extraInfo)
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "OwnershipTransferred")
+	if err != nil {
+		return nil, err
+	}
+	return &ISideChainManagerOwnershipTransferredIterator{contract: _ISideChainManager.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
 }
 
-// UpdateSideChain is a paid mutator transaction binding the contract method 0x956f1463.
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x5bef236c3d8ca4802fed22bae661560c9d4f6e9c79b438d14c05b1fe9190b6a7.
 //
-// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerSession) UpdateSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.UpdateSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+// Solidity: event OwnershipTransferred(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ISideChainManagerOwnershipTransferred) (event.Subscription, error) {
+
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "OwnershipTransferred")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ISideChainManagerOwnershipTransferred)
+				if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// UpdateSideChain is a paid mutator transaction binding the contract method 0x956f1463.
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x5bef236c3d8ca4802fed22bae661560c9d4f6e9c79b438d14c05b1fe9190b6a7.
 //
-// Solidity: function updateSideChain(uint64 chainID, uint64 router, string name, bytes CCMCAddress, bytes extraInfo) returns()
-func (_ISideChainManager *ISideChainManagerTransactorSession) UpdateSideChain(chainID uint64, router uint64, name string, CCMCAddress []byte, extraInfo []byte) (*types.Transaction, error) {
-	return _ISideChainManager.Contract.UpdateSideChain(&_ISideChainManager.TransactOpts, chainID, router, name, CCMCAddress, extraInfo)
+// Solidity: event OwnershipTransferred(uint64 ChainId, address PreviousOwner, address NewOwner)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseOwnershipTransferred(log types.Log) (*ISideChainManagerOwnershipTransferred, error) {
+	event := new(ISideChainManagerOwnershipTransferred)
+	if err := _ISideChainManager.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// ISideChainManagerApproveQuitSideChainIterator is returned from FilterApproveQuitSideChain and is used to iterate over the raw logs and unpacked data for ApproveQuitSideChain events raised by the ISideChainManager contract.
-type ISideChainManagerApproveQuitSideChainIterator struct {
-	Event *ISideChainManagerApproveQuitSideChain // Event containing the contract specifics and raw log
+// ISideChainManagerQuitSideChainIterator is returned from FilterQuitSideChain and is used to iterate over the raw logs and unpacked data for QuitSideChain events raised by the ISideChainManager contract.
+type ISideChainManagerQuitSideChainIterator struct {
+	Event *ISideChainManagerQuitSideChain // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -475,7 +3261,7 @@ type ISideChainManagerApproveQuitSideChainIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ISideChainManagerApproveQuitSideChainIterator) Next() bool {
+func (it *ISideChainManagerQuitSideChainIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -484,7 +3270,7 @@ func (it *ISideChainManagerApproveQuitSideChainIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ISideChainManagerApproveQuitSideChain)
+			it.Event = new(ISideChainManagerQuitSideChain)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -499,7 +3285,7 @@ func (it *ISideChainManagerApproveQuitSideChainIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ISideChainManagerApproveQuitSideChain)
+		it.Event = new(ISideChainManagerQuitSideChain)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -515,41 +3301,41 @@ func (it *ISideChainManagerApproveQuitSideChainIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ISideChainManagerApproveQuitSideChainIterator) Error() error {
+func (it *ISideChainManagerQuitSideChainIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ISideChainManagerApproveQuitSideChainIterator) Close() error {
+func (it *ISideChainManagerQuitSideChainIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ISideChainManagerApproveQuitSideChain represents a ApproveQuitSideChain event raised by the ISideChainManager contract.
-type ISideChainManagerApproveQuitSideChain struct {
+// ISideChainManagerQuitSideChain represents a QuitSideChain event raised by the ISideChainManager contract.
+type ISideChainManagerQuitSideChain struct {
 	ChainId uint64
 	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveQuitSideChain is a free log retrieval operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+// FilterQuitSideChain is a free log retrieval operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
 //
-// Solidity: event ApproveQuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveQuitSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveQuitSideChainIterator, error) {
+// Solidity: event QuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterQuitSideChain(opts *bind.FilterOpts) (*ISideChainManagerQuitSideChainIterator, error) {
 
-	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveQuitSideChain")
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "QuitSideChain")
 	if err != nil {
 		return nil, err
 	}
-	return &ISideChainManagerApproveQuitSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveQuitSideChain", logs: logs, sub: sub}, nil
+	return &ISideChainManagerQuitSideChainIterator{contract: _ISideChainManager.contract, event: "QuitSideChain", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveQuitSideChain is a free log subscription operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+// WatchQuitSideChain is a free log subscription operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
 //
-// Solidity: event ApproveQuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveQuitSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveQuitSideChain) (event.Subscription, error) {
+// Solidity: event QuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchQuitSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerQuitSideChain) (event.Subscription, error) {
 
-	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveQuitSideChain")
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "QuitSideChain")
 	if err != nil {
 		return nil, err
 	}
@@ -559,8 +3345,8 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveQuitSideChain(o
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ISideChainManagerApproveQuitSideChain)
-				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveQuitSideChain", log); err != nil {
+				event := new(ISideChainManagerQuitSideChain)
+				if err := _ISideChainManager.contract.UnpackLog(event, "QuitSideChain", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -581,21 +3367,21 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveQuitSideChain(o
 	}), nil
 }
 
-// ParseApproveQuitSideChain is a log parse operation binding the contract event 0x12d05899d2cf3e2ea805d35769f340779fdfa004e8b2d9342a47eb158d276e73.
+// ParseQuitSideChain is a log parse operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
 //
-// Solidity: event ApproveQuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveQuitSideChain(log types.Log) (*ISideChainManagerApproveQuitSideChain, error) {
-	event := new(ISideChainManagerApproveQuitSideChain)
-	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveQuitSideChain", log); err != nil {
+// Solidity: event QuitSideChain(uint64 ChainId)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseQuitSideChain(log types.Log) (*ISideChainManagerQuitSideChain, error) {
+	event := new(ISideChainManagerQuitSideChain)
+	if err := _ISideChainManager.contract.UnpackLog(event, "QuitSideChain", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ISideChainManagerApproveRegisterSideChainIterator is returned from FilterApproveRegisterSideChain and is used to iterate over the raw logs and unpacked data for ApproveRegisterSideChain events raised by the ISideChainManager contract.
-type ISideChainManagerApproveRegisterSideChainIterator struct {
-	Event *ISideChainManagerApproveRegisterSideChain // Event containing the contract specifics and raw log
+// ISideChainManagerRegisterSideChainIterator is returned from FilterRegisterSideChain and is used to iterate over the raw logs and unpacked data for RegisterSideChain events raised by the ISideChainManager contract.
+type ISideChainManagerRegisterSideChainIterator struct {
+	Event *ISideChainManagerRegisterSideChain // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -609,7 +3395,7 @@ type ISideChainManagerApproveRegisterSideChainIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ISideChainManagerApproveRegisterSideChainIterator) Next() bool {
+func (it *ISideChainManagerRegisterSideChainIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -618,7 +3404,7 @@ func (it *ISideChainManagerApproveRegisterSideChainIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ISideChainManagerApproveRegisterSideChain)
+			it.Event = new(ISideChainManagerRegisterSideChain)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -633,7 +3419,7 @@ func (it *ISideChainManagerApproveRegisterSideChainIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ISideChainManagerApproveRegisterSideChain)
+		it.Event = new(ISideChainManagerRegisterSideChain)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -649,41 +3435,43 @@ func (it *ISideChainManagerApproveRegisterSideChainIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ISideChainManagerApproveRegisterSideChainIterator) Error() error {
+func (it *ISideChainManagerRegisterSideChainIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ISideChainManagerApproveRegisterSideChainIterator) Close() error {
+func (it *ISideChainManagerRegisterSideChainIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ISideChainManagerApproveRegisterSideChain represents a ApproveRegisterSideChain event raised by the ISideChainManager contract.
-type ISideChainManagerApproveRegisterSideChain struct {
+// ISideChainManagerRegisterSideChain represents a RegisterSideChain event raised by the ISideChainManager contract.
+type ISideChainManagerRegisterSideChain struct {
 	ChainId uint64
+	Router  uint64
+	Name    string
 	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveRegisterSideChain is a free log retrieval operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+// FilterRegisterSideChain is a free log retrieval operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
 //
-// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveRegisterSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveRegisterSideChainIterator, error) {
+// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterRegisterSideChain(opts *bind.FilterOpts) (*ISideChainManagerRegisterSideChainIterator, error) {
 
-	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveRegisterSideChain")
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "RegisterSideChain")
 	if err != nil {
 		return nil, err
 	}
-	return &ISideChainManagerApproveRegisterSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveRegisterSideChain", logs: logs, sub: sub}, nil
+	return &ISideChainManagerRegisterSideChainIterator{contract: _ISideChainManager.contract, event: "RegisterSideChain", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveRegisterSideChain is a free log subscription operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+// WatchRegisterSideChain is a free log subscription operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
 //
-// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveRegisterSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveRegisterSideChain) (event.Subscription, error) {
+// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchRegisterSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerRegisterSideChain) (event.Subscription, error) {
 
-	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveRegisterSideChain")
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "RegisterSideChain")
 	if err != nil {
 		return nil, err
 	}
@@ -693,8 +3481,8 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveRegisterSideCha
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ISideChainManagerApproveRegisterSideChain)
-				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveRegisterSideChain", log); err != nil {
+				event := new(ISideChainManagerRegisterSideChain)
+				if err := _ISideChainManager.contract.UnpackLog(event, "RegisterSideChain", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -715,21 +3503,21 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveRegisterSideCha
 	}), nil
 }
 
-// ParseApproveRegisterSideChain is a log parse operation binding the contract event 0x7f80ce991f1aef1de58b0a4d15734c702a491b07b594a2655503a5e433fd5749.
+// ParseRegisterSideChain is a log parse operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
 //
-// Solidity: event ApproveRegisterSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveRegisterSideChain(log types.Log) (*ISideChainManagerApproveRegisterSideChain, error) {
-	event := new(ISideChainManagerApproveRegisterSideChain)
-	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveRegisterSideChain", log); err != nil {
+// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseRegisterSideChain(log types.Log) (*ISideChainManagerRegisterSideChain, error) {
+	event := new(ISideChainManagerRegisterSideChain)
+	if err := _ISideChainManager.contract.UnpackLog(event, "RegisterSideChain", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ISideChainManagerApproveUpdateSideChainIterator is returned from FilterApproveUpdateSideChain and is used to iterate over the raw logs and unpacked data for ApproveUpdateSideChain events raised by the ISideChainManager contract.
-type ISideChainManagerApproveUpdateSideChainIterator struct {
-	Event *ISideChainManagerApproveUpdateSideChain // Event containing the contract specifics and raw log
+// ISideChainManagerRejectRequestIterator is returned from FilterRejectRequest and is used to iterate over the raw logs and unpacked data for RejectRequest events raised by the ISideChainManager contract.
+type ISideChainManagerRejectRequestIterator struct {
+	Event *ISideChainManagerRejectRequest // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -743,7 +3531,7 @@ type ISideChainManagerApproveUpdateSideChainIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ISideChainManagerApproveUpdateSideChainIterator) Next() bool {
+func (it *ISideChainManagerRejectRequestIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -752,7 +3540,7 @@ func (it *ISideChainManagerApproveUpdateSideChainIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ISideChainManagerApproveUpdateSideChain)
+			it.Event = new(ISideChainManagerRejectRequest)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -767,7 +3555,7 @@ func (it *ISideChainManagerApproveUpdateSideChainIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ISideChainManagerApproveUpdateSideChain)
+		it.Event = new(ISideChainManagerRejectRequest)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -783,41 +3571,42 @@ func (it *ISideChainManagerApproveUpdateSideChainIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ISideChainManagerApproveUpdateSideChainIterator) Error() error {
+func (it *ISideChainManagerRejectRequestIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ISideChainManagerApproveUpdateSideChainIterator) Close() error {
+func (it *ISideChainManagerRejectRequestIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ISideChainManagerApproveUpdateSideChain represents a ApproveUpdateSideChain event raised by the ISideChainManager contract.
-type ISideChainManagerApproveUpdateSideChain struct {
-	ChainId uint64
-	Raw     types.Log // Blockchain specific contextual infos
+// ISideChainManagerRejectRequest represents a RejectRequest event raised by the ISideChainManager contract.
+type ISideChainManagerRejectRequest struct {
+	ChainId     uint64
+	RequestType uint8
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterApproveUpdateSideChain is a free log retrieval operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+// FilterRejectRequest is a free log retrieval operation binding the contract event 0x17d01073a0b7c44341199982f814c226e22c1d9c9d96f4bc9a16e0d50e3f797b.
 //
-// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) FilterApproveUpdateSideChain(opts *bind.FilterOpts) (*ISideChainManagerApproveUpdateSideChainIterator, error) {
+// Solidity: event RejectRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterRejectRequest(opts *bind.FilterOpts) (*ISideChainManagerRejectRequestIterator, error) {
 
-	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "ApproveUpdateSideChain")
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "RejectRequest")
 	if err != nil {
 		return nil, err
 	}
-	return &ISideChainManagerApproveUpdateSideChainIterator{contract: _ISideChainManager.contract, event: "ApproveUpdateSideChain", logs: logs, sub: sub}, nil
+	return &ISideChainManagerRejectRequestIterator{contract: _ISideChainManager.contract, event: "RejectRequest", logs: logs, sub: sub}, nil
 }
 
-// WatchApproveUpdateSideChain is a free log subscription operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+// WatchRejectRequest is a free log subscription operation binding the contract event 0x17d01073a0b7c44341199982f814c226e22c1d9c9d96f4bc9a16e0d50e3f797b.
 //
-// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveUpdateSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerApproveUpdateSideChain) (event.Subscription, error) {
+// Solidity: event RejectRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchRejectRequest(opts *bind.WatchOpts, sink chan<- *ISideChainManagerRejectRequest) (event.Subscription, error) {
 
-	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "ApproveUpdateSideChain")
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "RejectRequest")
 	if err != nil {
 		return nil, err
 	}
@@ -827,8 +3616,8 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveUpdateSideChain
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ISideChainManagerApproveUpdateSideChain)
-				if err := _ISideChainManager.contract.UnpackLog(event, "ApproveUpdateSideChain", log); err != nil {
+				event := new(ISideChainManagerRejectRequest)
+				if err := _ISideChainManager.contract.UnpackLog(event, "RejectRequest", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -849,21 +3638,21 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchApproveUpdateSideChain
 	}), nil
 }
 
-// ParseApproveUpdateSideChain is a log parse operation binding the contract event 0x24eae46246c3dc63bc634070751e269a353b630665bfc8fbc057b614629e8136.
+// ParseRejectRequest is a log parse operation binding the contract event 0x17d01073a0b7c44341199982f814c226e22c1d9c9d96f4bc9a16e0d50e3f797b.
 //
-// Solidity: event ApproveUpdateSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) ParseApproveUpdateSideChain(log types.Log) (*ISideChainManagerApproveUpdateSideChain, error) {
-	event := new(ISideChainManagerApproveUpdateSideChain)
-	if err := _ISideChainManager.contract.UnpackLog(event, "ApproveUpdateSideChain", log); err != nil {
+// Solidity: event RejectRequest(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseRejectRequest(log types.Log) (*ISideChainManagerRejectRequest, error) {
+	event := new(ISideChainManagerRejectRequest)
+	if err := _ISideChainManager.contract.UnpackLog(event, "RejectRequest", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ISideChainManagerQuitSideChainIterator is returned from FilterQuitSideChain and is used to iterate over the raw logs and unpacked data for QuitSideChain events raised by the ISideChainManager contract.
-type ISideChainManagerQuitSideChainIterator struct {
-	Event *ISideChainManagerQuitSideChain // Event containing the contract specifics and raw log
+// ISideChainManagerRequestExpiredIterator is returned from FilterRequestExpired and is used to iterate over the raw logs and unpacked data for RequestExpired events raised by the ISideChainManager contract.
+type ISideChainManagerRequestExpiredIterator struct {
+	Event *ISideChainManagerRequestExpired // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -877,7 +3666,7 @@ type ISideChainManagerQuitSideChainIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ISideChainManagerQuitSideChainIterator) Next() bool {
+func (it *ISideChainManagerRequestExpiredIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -886,7 +3675,7 @@ func (it *ISideChainManagerQuitSideChainIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ISideChainManagerQuitSideChain)
+			it.Event = new(ISideChainManagerRequestExpired)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -901,7 +3690,7 @@ func (it *ISideChainManagerQuitSideChainIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ISideChainManagerQuitSideChain)
+		it.Event = new(ISideChainManagerRequestExpired)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -917,41 +3706,42 @@ func (it *ISideChainManagerQuitSideChainIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ISideChainManagerQuitSideChainIterator) Error() error {
+func (it *ISideChainManagerRequestExpiredIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ISideChainManagerQuitSideChainIterator) Close() error {
+func (it *ISideChainManagerRequestExpiredIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ISideChainManagerQuitSideChain represents a QuitSideChain event raised by the ISideChainManager contract.
-type ISideChainManagerQuitSideChain struct {
-	ChainId uint64
-	Raw     types.Log // Blockchain specific contextual infos
+// ISideChainManagerRequestExpired represents a RequestExpired event raised by the ISideChainManager contract.
+type ISideChainManagerRequestExpired struct {
+	ChainId     uint64
+	RequestType uint8
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterQuitSideChain is a free log retrieval operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
+// FilterRequestExpired is a free log retrieval operation binding the contract event 0x580288c85af33e740768ec3e27dfcb297ed388cd6aa0045c4ca64181ed41f336.
 //
-// Solidity: event QuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) FilterQuitSideChain(opts *bind.FilterOpts) (*ISideChainManagerQuitSideChainIterator, error) {
+// Solidity: event RequestExpired(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterRequestExpired(opts *bind.FilterOpts) (*ISideChainManagerRequestExpiredIterator, error) {
 
-	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "QuitSideChain")
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "RequestExpired")
 	if err != nil {
 		return nil, err
 	}
-	return &ISideChainManagerQuitSideChainIterator{contract: _ISideChainManager.contract, event: "QuitSideChain", logs: logs, sub: sub}, nil
+	return &ISideChainManagerRequestExpiredIterator{contract: _ISideChainManager.contract, event: "RequestExpired", logs: logs, sub: sub}, nil
 }
 
-// WatchQuitSideChain is a free log subscription operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
+// WatchRequestExpired is a free log subscription operation binding the contract event 0x580288c85af33e740768ec3e27dfcb297ed388cd6aa0045c4ca64181ed41f336.
 //
-// Solidity: event QuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) WatchQuitSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerQuitSideChain) (event.Subscription, error) {
+// Solidity: event RequestExpired(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchRequestExpired(opts *bind.WatchOpts, sink chan<- *ISideChainManagerRequestExpired) (event.Subscription, error) {
 
-	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "QuitSideChain")
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "RequestExpired")
 	if err != nil {
 		return nil, err
 	}
@@ -961,8 +3751,8 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchQuitSideChain(opts *bi
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ISideChainManagerQuitSideChain)
-				if err := _ISideChainManager.contract.UnpackLog(event, "QuitSideChain", log); err != nil {
+				event := new(ISideChainManagerRequestExpired)
+				if err := _ISideChainManager.contract.UnpackLog(event, "RequestExpired", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -983,21 +3773,21 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchQuitSideChain(opts *bi
 	}), nil
 }
 
-// ParseQuitSideChain is a log parse operation binding the contract event 0xd5e9edc8ae17c077aca5871ac2653f2acb7fb85796fb7c5b43f5ea907c198e90.
+// ParseRequestExpired is a log parse operation binding the contract event 0x580288c85af33e740768ec3e27dfcb297ed388cd6aa0045c4ca64181ed41f336.
 //
-// Solidity: event QuitSideChain(uint64 ChainId)
-func (_ISideChainManager *ISideChainManagerFilterer) ParseQuitSideChain(log types.Log) (*ISideChainManagerQuitSideChain, error) {
-	event := new(ISideChainManagerQuitSideChain)
-	if err := _ISideChainManager.contract.UnpackLog(event, "QuitSideChain", log); err != nil {
+// Solidity: event RequestExpired(uint64 ChainId, uint8 RequestType)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseRequestExpired(log types.Log) (*ISideChainManagerRequestExpired, error) {
+	event := new(ISideChainManagerRequestExpired)
+	if err := _ISideChainManager.contract.UnpackLog(event, "RequestExpired", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ISideChainManagerRegisterSideChainIterator is returned from FilterRegisterSideChain and is used to iterate over the raw logs and unpacked data for RegisterSideChain events raised by the ISideChainManager contract.
-type ISideChainManagerRegisterSideChainIterator struct {
-	Event *ISideChainManagerRegisterSideChain // Event containing the contract specifics and raw log
+// ISideChainManagerSideChainStatusChangedIterator is returned from FilterSideChainStatusChanged and is used to iterate over the raw logs and unpacked data for SideChainStatusChanged events raised by the ISideChainManager contract.
+type ISideChainManagerSideChainStatusChangedIterator struct {
+	Event *ISideChainManagerSideChainStatusChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1011,7 +3801,7 @@ type ISideChainManagerRegisterSideChainIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ISideChainManagerRegisterSideChainIterator) Next() bool {
+func (it *ISideChainManagerSideChainStatusChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1020,7 +3810,7 @@ func (it *ISideChainManagerRegisterSideChainIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ISideChainManagerRegisterSideChain)
+			it.Event = new(ISideChainManagerSideChainStatusChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1035,7 +3825,7 @@ func (it *ISideChainManagerRegisterSideChainIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ISideChainManagerRegisterSideChain)
+		it.Event = new(ISideChainManagerSideChainStatusChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1051,43 +3841,43 @@ func (it *ISideChainManagerRegisterSideChainIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ISideChainManagerRegisterSideChainIterator) Error() error {
+func (it *ISideChainManagerSideChainStatusChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ISideChainManagerRegisterSideChainIterator) Close() error {
+func (it *ISideChainManagerSideChainStatusChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ISideChainManagerRegisterSideChain represents a RegisterSideChain event raised by the ISideChainManager contract.
-type ISideChainManagerRegisterSideChain struct {
-	ChainId uint64
-	Router  uint64
-	Name    string
-	Raw     types.Log // Blockchain specific contextual infos
+// ISideChainManagerSideChainStatusChanged represents a SideChainStatusChanged event raised by the ISideChainManager contract.
+type ISideChainManagerSideChainStatusChanged struct {
+	ChainId        uint64
+	PreviousStatus uint8
+	Status         uint8
+	Raw            types.Log // Blockchain specific contextual infos
 }
 
-// FilterRegisterSideChain is a free log retrieval operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
+// FilterSideChainStatusChanged is a free log retrieval operation binding the contract event 0xfc2592cbe008d4576008f164ed245f0b57eeab93ada66a8508f12fec1d916e6b.
 //
-// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
-func (_ISideChainManager *ISideChainManagerFilterer) FilterRegisterSideChain(opts *bind.FilterOpts) (*ISideChainManagerRegisterSideChainIterator, error) {
+// Solidity: event SideChainStatusChanged(uint64 ChainId, uint8 PreviousStatus, uint8 Status)
+func (_ISideChainManager *ISideChainManagerFilterer) FilterSideChainStatusChanged(opts *bind.FilterOpts) (*ISideChainManagerSideChainStatusChangedIterator, error) {
 
-	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "RegisterSideChain")
+	logs, sub, err := _ISideChainManager.contract.FilterLogs(opts, "SideChainStatusChanged")
 	if err != nil {
 		return nil, err
 	}
-	return &ISideChainManagerRegisterSideChainIterator{contract: _ISideChainManager.contract, event: "RegisterSideChain", logs: logs, sub: sub}, nil
+	return &ISideChainManagerSideChainStatusChangedIterator{contract: _ISideChainManager.contract, event: "SideChainStatusChanged", logs: logs, sub: sub}, nil
 }
 
-// WatchRegisterSideChain is a free log subscription operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
+// WatchSideChainStatusChanged is a free log subscription operation binding the contract event 0xfc2592cbe008d4576008f164ed245f0b57eeab93ada66a8508f12fec1d916e6b.
 //
-// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
-func (_ISideChainManager *ISideChainManagerFilterer) WatchRegisterSideChain(opts *bind.WatchOpts, sink chan<- *ISideChainManagerRegisterSideChain) (event.Subscription, error) {
+// Solidity: event SideChainStatusChanged(uint64 ChainId, uint8 PreviousStatus, uint8 Status)
+func (_ISideChainManager *ISideChainManagerFilterer) WatchSideChainStatusChanged(opts *bind.WatchOpts, sink chan<- *ISideChainManagerSideChainStatusChanged) (event.Subscription, error) {
 
-	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "RegisterSideChain")
+	logs, sub, err := _ISideChainManager.contract.WatchLogs(opts, "SideChainStatusChanged")
 	if err != nil {
 		return nil, err
 	}
@@ -1097,8 +3887,8 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchRegisterSideChain(opts
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ISideChainManagerRegisterSideChain)
-				if err := _ISideChainManager.contract.UnpackLog(event, "RegisterSideChain", log); err != nil {
+				event := new(ISideChainManagerSideChainStatusChanged)
+				if err := _ISideChainManager.contract.UnpackLog(event, "SideChainStatusChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1119,12 +3909,12 @@ func (_ISideChainManager *ISideChainManagerFilterer) WatchRegisterSideChain(opts
 	}), nil
 }
 
-// ParseRegisterSideChain is a log parse operation binding the contract event 0x7688735bc7c43b9ea9af0a45be0fc37526cf866360acaf0c29c44185f8eee791.
+// ParseSideChainStatusChanged is a log parse operation binding the contract event 0xfc2592cbe008d4576008f164ed245f0b57eeab93ada66a8508f12fec1d916e6b.
 //
-// Solidity: event RegisterSideChain(uint64 ChainId, uint64 Router, string Name)
-func (_ISideChainManager *ISideChainManagerFilterer) ParseRegisterSideChain(log types.Log) (*ISideChainManagerRegisterSideChain, error) {
-	event := new(ISideChainManagerRegisterSideChain)
-	if err := _ISideChainManager.contract.UnpackLog(event, "RegisterSideChain", log); err != nil {
+// Solidity: event SideChainStatusChanged(uint64 ChainId, uint8 PreviousStatus, uint8 Status)
+func (_ISideChainManager *ISideChainManagerFilterer) ParseSideChainStatusChanged(log types.Log) (*ISideChainManagerSideChainStatusChanged, error) {
+	event := new(ISideChainManagerSideChainStatusChanged)
+	if err := _ISideChainManager.contract.UnpackLog(event, "SideChainStatusChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -30,11 +31,24 @@ import (
 )
 
 var (
-	MethodContractName  = info_sync_abi.MethodName
-	MethodSyncRootInfo  = info_sync_abi.MethodSyncRootInfo
-	MethodReplenish     = info_sync_abi.MethodReplenish
-	MethodGetInfoHeight = info_sync_abi.MethodGetInfoHeight
-	MethodGetInfo       = info_sync_abi.MethodGetInfo
+	MethodContractName           = info_sync_abi.MethodName
+	MethodSyncRootInfo           = info_sync_abi.MethodSyncRootInfo
+	MethodReplenish              = info_sync_abi.MethodReplenish
+	MethodGetInfoHeight          = info_sync_abi.MethodGetInfoHeight
+	MethodGetInfo                = info_sync_abi.MethodGetInfo
+	MethodSetRetention           = info_sync_abi.MethodSetRetention
+	MethodPinRootInfo            = info_sync_abi.MethodPinRootInfo
+	MethodGetRetention           = info_sync_abi.MethodGetRetention
+	MethodGetInfoRange           = info_sync_abi.MethodGetInfoRange
+	MethodGetMissingHeights      = info_sync_abi.MethodGetMissingHeights
+	MethodGetSyncProgress        = info_sync_abi.MethodGetSyncProgress
+	MethodReplenish64            = info_sync_abi.MethodReplenish64
+	MethodGetInfoHeight64        = info_sync_abi.MethodGetInfoHeight64
+	MethodGetInfo64              = info_sync_abi.MethodGetInfo64
+	MethodSyncRootInfoAggregated = info_sync_abi.MethodSyncRootInfoAggregated
+	MethodGetStateRoot           = info_sync_abi.MethodGetStateRoot
+	MethodGetReceiptsRoot        = info_sync_abi.MethodGetReceiptsRoot
+	MethodGetHeaderFields        = info_sync_abi.MethodGetHeaderFields
 )
 
 func GetABI() *abi.ABI {
@@ -67,6 +81,155 @@ func (m *GetInfoOutput) Decode(payload []byte) error {
 	return nil
 }
 
+type GetInfo64Param struct {
+	ChainID uint64
+	Height  uint64
+}
+
+func (m *GetInfo64Param) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetInfo64, m)
+}
+
+type GetInfo64Output struct {
+	Info []byte
+}
+
+func (m *GetInfo64Output) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetInfo64, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetStateRootParam struct {
+	ChainID uint64
+	Height  uint64
+}
+
+func (m *GetStateRootParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetStateRoot, m)
+}
+
+type GetStateRootOutput struct {
+	StateRoot common.Hash
+}
+
+func (m *GetStateRootOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetStateRoot, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetReceiptsRootParam struct {
+	ChainID uint64
+	Height  uint64
+}
+
+func (m *GetReceiptsRootParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetReceiptsRoot, m)
+}
+
+type GetReceiptsRootOutput struct {
+	ReceiptsRoot common.Hash
+}
+
+func (m *GetReceiptsRootOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetReceiptsRoot, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetHeaderFieldsParam struct {
+	ChainID uint64
+	Height  uint64
+}
+
+func (m *GetHeaderFieldsParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetHeaderFields, m)
+}
+
+type GetHeaderFieldsOutput struct {
+	StateRoot        common.Hash
+	ReceiptsRoot     common.Hash
+	TransactionsRoot common.Hash
+	BlockHash        common.Hash
+	Timestamp        uint64
+}
+
+func (m *GetHeaderFieldsOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetHeaderFields, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetInfoRangeParam struct {
+	ChainID uint64
+	From    uint64
+	To      uint64
+}
+
+func (m *GetInfoRangeParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetInfoRange, m)
+}
+
+type GetInfoRangeOutput struct {
+	Heights []uint64
+	Infos   [][]byte
+}
+
+func (m *GetInfoRangeOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetInfoRange, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetMissingHeightsParam struct {
+	ChainID uint64
+	From    uint64
+	To      uint64
+}
+
+func (m *GetMissingHeightsParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetMissingHeights, m)
+}
+
+type GetMissingHeightsOutput struct {
+	Heights []uint64
+}
+
+func (m *GetMissingHeightsOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetMissingHeights, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetSyncProgressParam struct {
+	ChainID  uint64
+	Height   uint64
+	InfoHash common.Hash
+}
+
+func (m *GetSyncProgressParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetSyncProgress, m)
+}
+
+type GetSyncProgressOutput struct {
+	Voters []common.Address
+	Quorum uint64
+}
+
+func (m *GetSyncProgressOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetSyncProgress, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
 type GetInfoHeightParam struct {
 	ChainID uint64
 }
@@ -86,6 +249,25 @@ func (m *GetInfoHeightOutput) Decode(payload []byte) error {
 	return nil
 }
 
+type GetInfoHeight64Param struct {
+	ChainID uint64
+}
+
+func (m *GetInfoHeight64Param) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetInfoHeight64, m)
+}
+
+type GetInfoHeight64Output struct {
+	Height uint64
+}
+
+func (m *GetInfoHeight64Output) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetInfoHeight64, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
+
 type SyncRootInfoParam struct {
 	ChainID   uint64
 	RootInfos [][]byte
@@ -110,6 +292,21 @@ func (m *SyncRootInfoParam) Digest() ([]byte, error) {
 	return digest, nil
 }
 
+type SyncRootInfoAggregatedParam struct {
+	ChainID    uint64
+	RootInfos  [][]byte
+	Signatures [][]byte
+}
+
+func (m *SyncRootInfoAggregatedParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodSyncRootInfoAggregated, m)
+}
+
+// Digest is the digest of SyncRootInfoParam with the same chain id and root infos, signed by each voter
+func (m *SyncRootInfoAggregatedParam) Digest() ([]byte, error) {
+	return (&SyncRootInfoParam{ChainID: m.ChainID, RootInfos: m.RootInfos}).Digest()
+}
+
 type ReplenishParam struct {
 	ChainID uint64
 	Heights []uint32
@@ -118,3 +315,51 @@ type ReplenishParam struct {
 func (m *ReplenishParam) Encode() ([]byte, error) {
 	return contract.PackMethodWithStruct(ABI, MethodReplenish, m)
 }
+
+type Replenish64Param struct {
+	ChainID uint64
+	Heights []uint64
+}
+
+func (m *Replenish64Param) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodReplenish64, m)
+}
+
+type SetRetentionParam struct {
+	ChainID uint64
+	Window  uint64
+}
+
+func (m *SetRetentionParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodSetRetention, m)
+}
+
+type PinRootInfoParam struct {
+	ChainID uint64
+	Height  uint64
+	Pinned  bool
+}
+
+func (m *PinRootInfoParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodPinRootInfo, m)
+}
+
+type GetRetentionParam struct {
+	ChainID uint64
+}
+
+func (m *GetRetentionParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetRetention, m)
+}
+
+type GetRetentionOutput struct {
+	Window       uint64
+	PrunedHeight uint64
+}
+
+func (m *GetRetentionOutput) Decode(payload []byte) error {
+	if err := contract.UnpackOutputs(ABI, MethodGetRetention, m, payload); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package info_sync
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/cfg"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+// evidence kind reported to node_manager for a voter signing two root infos of one height
+const EVIDENCE_ROOT_INFO_EQUIVOCATION = "RootInfoEquivocation"
+
+// RootInfoVote is the standing vote of a voter for a height, with the signed root infos of the sync call it was
+// cast in so the signature can be checked against them
+type RootInfoVote struct {
+	Voter     common.Address
+	InfoHash  common.Hash
+	RootInfos [][]byte
+	Signature []byte
+	Reported  bool
+	Round     uint64 `rlp:"optional"`
+}
+
+// Digest is the digest the voter signed, the same as SyncRootInfoParam.Digest of the sync call
+func (this *RootInfoVote) Digest(chainID uint64) ([]byte, error) {
+	return (&SyncRootInfoParam{ChainID: chainID, RootInfos: this.RootInfos}).Digest()
+}
+
+// info returns the root info of a height the vote is for, nil if it is not among the signed root infos
+func (this *RootInfoVote) info(height uint64) []byte {
+	for _, raw := range this.RootInfos {
+		rootInfo := new(RootInfo)
+		if err := rlp.DecodeBytes(raw, rootInfo); err != nil {
+			continue
+		}
+		if rootInfo.Height == height && crypto.Keccak256Hash(rootInfo.Info) == this.InfoHash {
+			return rootInfo.Info
+		}
+	}
+	return nil
+}
+
+// Equivocation is the evidence of a voter signing two different root infos for the same height
+type Equivocation struct {
+	ChainID uint64
+	Height  uint64
+	First   *RootInfoVote
+	Second  *RootInfoVote
+}
+
+// checkRootInfoVote records the vote of a voter and tells whether it may be counted. A voter may replace its vote
+// for a height as long as no info of the height reached the quorum, the consensus sign of the replaced vote is
+// revoked. The replacement has to be signed for a later round than the standing vote, so a vote signed before
+// can not be replayed to take the replacement back. A vote for another info than the finalised one emits RootInfoConflict and is not counted, if the voter
+// voted for the finalised info before both signatures stand and it is reported to node_manager as evidence once
+// per voter and height. It also tells whether the vote changed anything, a vote cast before is counted again
+// without a change
+func checkRootInfoVote(module *contract.ModuleContract, chainID uint64, rootInfo *RootInfo, vote *RootInfoVote) (bool, bool, error) {
+	counted, changed, err := checkRootInfoVotes(module, chainID, rootInfo, []*RootInfoVote{vote})
+	return counted == 1, changed, err
+}
+
+// checkRootInfoVotes is checkRootInfoVote for votes of distinct voters on one root info, the votes of the height
+// are read and written once. It returns the number of votes that may be counted and whether any vote was recorded
+// or reported
+func checkRootInfoVotes(module *contract.ModuleContract, chainID uint64, rootInfo *RootInfo, batch []*RootInfoVote) (int, bool, error) {
+	votes, err := getRootInfoVotes(module, chainID, rootInfo.Height)
+	if err != nil {
+		return 0, false, err
+	}
+	stored, err := GetRootInfo(module, chainID, rootInfo.Height)
+	if err != nil {
+		return 0, false, err
+	}
+	counted, changed := 0, false
+	for _, vote := range batch {
+		index := -1
+		for i, v := range votes {
+			if v.Voter == vote.Voter {
+				index = i
+				break
+			}
+		}
+		if index >= 0 && votes[index].InfoHash == vote.InfoHash {
+			counted++
+			continue
+		}
+		if stored != nil && !bytes.Equal(stored, rootInfo.Info) {
+			storedHash := crypto.Keccak256Hash(stored)
+			if index >= 0 && votes[index].InfoHash == storedHash && !votes[index].Reported {
+				if err := reportEquivocation(module, chainID, rootInfo.Height, votes[index], vote); err != nil {
+					return 0, false, err
+				}
+				votes[index].Reported = true
+				changed = true
+			}
+			if err := NotifyRootInfoConflict(module, chainID, rootInfo.Height, vote.Voter, storedHash, vote.InfoHash); err != nil {
+				return 0, false, err
+			}
+			continue
+		}
+		if index >= 0 {
+			if vote.Round <= votes[index].Round {
+				continue
+			}
+			if err := revokeRootInfoVote(module, chainID, rootInfo.Height, votes[index]); err != nil {
+				return 0, false, err
+			}
+			votes = append(votes[:index], votes[index+1:]...)
+		}
+		votes = append(votes, vote)
+		counted++
+		changed = true
+	}
+	if changed {
+		if err := putRootInfoVotes(module, chainID, rootInfo.Height, votes); err != nil {
+			return 0, false, err
+		}
+	}
+	return counted, changed, nil
+}
+
+// revokeRootInfoVote takes the consensus sign of a replaced vote back, votes of aggregated calls have none
+func revokeRootInfoVote(module *contract.ModuleContract, chainID uint64, height uint64, vote *RootInfoVote) error {
+	info := vote.info(height)
+	if info == nil {
+		return nil
+	}
+	blob, err := rlp.EncodeToBytes(&RootInfoUnique{ChainID: chainID, Height: height, Info: info})
+	if err != nil {
+		return fmt.Errorf("revokeRootInfoVote, rlp.EncodeToBytes root info error: %v", err)
+	}
+	if err := node_manager.RevokeConsensusSign(module, MethodSyncRootInfo, blob, vote.Voter); err != nil {
+		return fmt.Errorf("revokeRootInfoVote, node_manager.RevokeConsensusSign error: %v", err)
+	}
+	return nil
+}
+
+func reportEquivocation(module *contract.ModuleContract, chainID uint64, height uint64, first, second *RootInfoVote) error {
+	data, err := rlp.EncodeToBytes(&Equivocation{chainID, height, first, second})
+	if err != nil {
+		return fmt.Errorf("reportEquivocation, rlp.EncodeToBytes equivocation error: %v", err)
+	}
+	err = node_manager.ReportEvidence(module, first.Voter, &node_manager.Evidence{
+		Module: this,
+		Kind:   EVIDENCE_ROOT_INFO_EQUIVOCATION,
+		Height: module.ContractRef().BlockHeight().Uint64(),
+		Data:   data,
+	})
+	if err != nil {
+		return fmt.Errorf("reportEquivocation, node_manager.ReportEvidence error: %v", err)
+	}
+	return nil
+}
+
+func rootInfoVotesKey(chainID uint64, height uint64) []byte {
+	if height > math.MaxUint32 {
+		return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(ROOT_INFO_VOTES_64), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(height))
+	}
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(ROOT_INFO_VOTES), utils.GetUint64Bytes(chainID), utils.GetUint32Bytes(uint32(height)))
+}
+
+func getRootInfoVotes(module *contract.ModuleContract, chainID uint64, height uint64) ([]*RootInfoVote, error) {
+	store, err := module.GetCacheDB().Get(rootInfoVotesKey(chainID, height))
+	if err != nil {
+		return nil, fmt.Errorf("getRootInfoVotes, module.GetCacheDB().Get error: %v", err)
+	}
+	votes := make([]*RootInfoVote, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &votes); err != nil {
+			return nil, fmt.Errorf("getRootInfoVotes, deserialize votes error: %v", err)
+		}
+	}
+	return votes, nil
+}
+
+func putRootInfoVotes(module *contract.ModuleContract, chainID uint64, height uint64, votes []*RootInfoVote) error {
+	blob, err := rlp.EncodeToBytes(votes)
+	if err != nil {
+		return fmt.Errorf("putRootInfoVotes, rlp.EncodeToBytes votes error: %v", err)
+	}
+	return module.GetCacheDB().Put(rootInfoVotesKey(chainID, height), blob)
+}
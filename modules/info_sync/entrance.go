@@ -19,13 +19,18 @@
 package info_sync
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"math"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
 	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/polynetwork/zion-example/modules/relayer_manager"
 	"github.com/polynetwork/zion-example/modules/side_chain_manager"
 )
 
@@ -33,9 +38,24 @@ var (
 	this = cfg.InfoSyncContractAddress
 )
 
+const (
+	// heights a range query can scan in one call
+	MAX_QUERY_RANGE = 1000
+	// heights a replenish call can request
+	MAX_REPLENISH_HEIGHTS = 200
+	// blocks in which a height is not requested again
+	REPLENISH_WINDOW = 100
+	// heights above the current height of a chain a replenish call can request
+	MAX_REPLENISH_AHEAD = 1000
+)
+
 func InitInfoSync() {
 	ABI = GetABI()
 	contract.Contracts.RegisterContract(this, RegisterInfoSyncContract)
+
+	side_chain_manager.RegisterSyncedHeight(GetCurrentHeight)
+	side_chain_manager.RegisterChainCleaner("info_sync.root_info", CleanRootInfo)
+	node_manager.RegisterEndBlockHook("info_sync.prune", PruneRootInfo)
 }
 
 func RegisterInfoSyncContract(s *contract.ModuleContract) {
@@ -43,9 +63,22 @@ func RegisterInfoSyncContract(s *contract.ModuleContract) {
 
 	s.Register(MethodContractName, Name)
 	s.Register(MethodSyncRootInfo, SyncRootInfo)
+	s.Register(MethodSyncRootInfoAggregated, SyncRootInfoAggregated)
 	s.Register(MethodReplenish, Replenish)
+	s.Register(MethodReplenish64, Replenish64)
 	s.Register(MethodGetInfoHeight, GetInfoHeight)
+	s.Register(MethodGetInfoHeight64, GetInfoHeight64)
 	s.Register(MethodGetInfo, GetInfo)
+	s.Register(MethodGetInfo64, GetInfo64)
+	s.Register(MethodGetStateRoot, GetStateRoot)
+	s.Register(MethodGetReceiptsRoot, GetReceiptsRoot)
+	s.Register(MethodGetHeaderFields, GetHeaderFields)
+	s.Register(MethodGetInfoRange, GetInfoRange)
+	s.Register(MethodGetMissingHeights, GetMissingHeights)
+	s.Register(MethodGetSyncProgress, GetSyncProgress)
+	s.Register(MethodSetRetention, SetRetention)
+	s.Register(MethodPinRootInfo, PinRootInfo)
+	s.Register(MethodGetRetention, GetRetention)
 }
 
 func Name(s *contract.ModuleContract) ([]byte, error) {
@@ -58,6 +91,10 @@ func SyncRootInfo(s *contract.ModuleContract) ([]byte, error) {
 	if err := contract.UnpackMethod(ABI, MethodSyncRootInfo, params, ctx.Payload); err != nil {
 		return nil, err
 	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("SyncRootInfo, %v", err)
+	}
 	chainID := params.ChainID
 
 	//check if chainid exist
@@ -79,14 +116,51 @@ func SyncRootInfo(s *contract.ModuleContract) ([]byte, error) {
 		return nil, fmt.Errorf("SyncRootInfo, crypto.SigToPub error: %v", err)
 	}
 	addr := crypto.PubkeyToAddress(*pub)
+	// only votes of voters are recorded for conflict detection
+	epoch, err := node_manager.GetCurrentEpochInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfo, node_manager.GetCurrentEpochInfoImpl error: %v", err)
+	}
+	if err := node_manager.CheckVoterAuthority(addr, epoch); err != nil {
+		return nil, fmt.Errorf("SyncRootInfo, node_manager.CheckVoterAuthority error: %v", err)
+	}
 
-	//sync root infos
+	// root infos of header synced chains have to extend the stored header chain before they can be voted
+	headers, err := newHeaderChain(s, sideChain)
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfo, %v", err)
+	}
+
+	//sync root infos, a call that casts no new vote is refused
+	changed, progress := false, false
 	for _, v := range params.RootInfos {
-		var rootInfo *RootInfo
-		err := rlp.DecodeBytes(v, &rootInfo)
+		rootInfo, err := prepareRootInfo(s, sideChain, headers, v)
+		if err != nil {
+			return nil, fmt.Errorf("SyncRootInfo, %v", err)
+		}
+		if rootInfo == nil {
+			continue
+		}
+		vote := &RootInfoVote{Voter: addr, InfoHash: crypto.Keccak256Hash(rootInfo.Info), RootInfos: params.RootInfos,
+			Signature: params.Signature, Round: rootInfo.Round}
+		counted, voted, err := checkRootInfoVote(s, chainID, rootInfo, vote)
 		if err != nil {
-			return nil, fmt.Errorf("SyncRootInfo, decode root info error")
+			return nil, fmt.Errorf("SyncRootInfo, checkRootInfoVote error: %v", err)
 		}
+		changed = changed || voted
+		if !counted {
+			// the votes of a voter for headers built on one it can not vote for are not cast either
+			if headers != nil {
+				break
+			}
+			continue
+		}
+		// a vote cast before is signed already
+		if !voted {
+			continue
+		}
+		progress = true
+
 		//use chain id, info key and value as unique id
 		unique := &RootInfoUnique{
 			ChainID: params.ChainID,
@@ -103,30 +177,315 @@ func SyncRootInfo(s *contract.ModuleContract) ([]byte, error) {
 			return nil, fmt.Errorf("SyncRootInfo, CheckVoterSigns error: %v", err)
 		}
 		if ok {
-			err := PutRootInfo(s, chainID, rootInfo.Height, rootInfo.Info)
-			if err != nil {
-				return nil, fmt.Errorf("SyncRootInfo, PutCrossChainInfo error: %v", err)
+			if err := commitRootInfo(s, chainID, headers, rootInfo); err != nil {
+				return nil, fmt.Errorf("SyncRootInfo, %v", err)
 			}
 		}
 	}
 
+	if !changed {
+		return nil, fmt.Errorf("SyncRootInfo, no new vote of root infos")
+	}
+	if err := relayer_manager.RecordSubmission(s, relayer, progress); err != nil {
+		return nil, fmt.Errorf("SyncRootInfo, %v", err)
+	}
 	return contract.PackOutputs(ABI, MethodSyncRootInfo, true)
 }
 
+// SyncRootInfoAggregated commits a batch of root infos signed by a quorum of voters in one call. The signatures
+// are over the same digest as SyncRootInfo, so a voter's signature can be relayed either way
+func SyncRootInfoAggregated(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &SyncRootInfoAggregatedParam{}
+	if err := contract.UnpackMethod(ABI, MethodSyncRootInfoAggregated, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, %v", err)
+	}
+	chainID := params.ChainID
+
+	sideChain, err := side_chain_manager.GetSideChainObject(s, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, side_chain_manager.GetSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, side chain is not registered")
+	}
+
+	//verify signatures, every one has to come from a distinct voter of the current epoch
+	digest, err := params.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, digest input param error: %v", err)
+	}
+	epoch, err := node_manager.GetCurrentEpochInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, node_manager.GetCurrentEpochInfoImpl error: %v", err)
+	}
+	voters := make([]common.Address, 0, len(params.Signatures))
+	for _, sig := range params.Signatures {
+		pub, err := crypto.SigToPub(digest, sig)
+		if err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, crypto.SigToPub error: %v", err)
+		}
+		addr := crypto.PubkeyToAddress(*pub)
+		if err := node_manager.CheckVoterAuthority(addr, epoch); err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, node_manager.CheckVoterAuthority error: %v", err)
+		}
+		for _, voter := range voters {
+			if voter == addr {
+				return nil, fmt.Errorf("SyncRootInfoAggregated, duplicate signature of voter %s", addr.Hex())
+			}
+		}
+		voters = append(voters, addr)
+	}
+	quorum := epoch.VoterQuorumSize()
+	if len(voters) < quorum {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, signatures of %d voters are below quorum %d", len(voters), quorum)
+	}
+
+	headers, err := newHeaderChain(s, sideChain)
+	if err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, %v", err)
+	}
+
+	//commit root infos, the votes are recorded for conflict detection but not counted by node_manager
+	changed, committed := false, false
+	for _, v := range params.RootInfos {
+		rootInfo, err := prepareRootInfo(s, sideChain, headers, v)
+		if err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, %v", err)
+		}
+		if rootInfo == nil {
+			continue
+		}
+		stored, err := GetRootInfo(s, chainID, rootInfo.Height)
+		if err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, GetRootInfo error: %v", err)
+		}
+		if bytes.Equal(stored, rootInfo.Info) {
+			continue
+		}
+		infoHash := crypto.Keccak256Hash(rootInfo.Info)
+		votes := make([]*RootInfoVote, 0, len(voters))
+		for i, voter := range voters {
+			votes = append(votes, &RootInfoVote{Voter: voter, InfoHash: infoHash, RootInfos: params.RootInfos,
+				Signature: params.Signatures[i], Round: rootInfo.Round})
+		}
+		counted, voted, err := checkRootInfoVotes(s, chainID, rootInfo, votes)
+		if err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, checkRootInfoVotes error: %v", err)
+		}
+		changed = changed || voted
+		if counted < quorum {
+			// the headers after one left uncommitted would not extend the stored tip
+			if headers != nil {
+				break
+			}
+			continue
+		}
+		if err := commitRootInfo(s, chainID, headers, rootInfo); err != nil {
+			return nil, fmt.Errorf("SyncRootInfoAggregated, %v", err)
+		}
+		committed = true
+	}
+
+	// a batch committing nothing is refused unless it records new votes, only commits are rewarded
+	if !changed && !committed {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, no new root info committed")
+	}
+	if err := relayer_manager.RecordSubmission(s, relayer, committed); err != nil {
+		return nil, fmt.Errorf("SyncRootInfoAggregated, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodSyncRootInfoAggregated, true)
+}
+
+// prepareRootInfo decodes a submitted root info, it returns nil for one that is pruned or already in the stored
+// header chain. Root infos of evm chains have to be headers the fields can be indexed from
+func prepareRootInfo(s *contract.ModuleContract, sideChain *side_chain_manager.SideChain, headers *headerChain, raw []byte) (*RootInfo, error) {
+	chainID := sideChain.ChainID
+	var rootInfo *RootInfo
+	if err := rlp.DecodeBytes(raw, &rootInfo); err != nil {
+		return nil, fmt.Errorf("decode root info error")
+	}
+	if hasHeaderFields(sideChain) {
+		if _, err := DecodeHeaderFields(rootInfo.Info); err != nil {
+			return nil, fmt.Errorf("root info at height %d, %v", rootInfo.Height, err)
+		}
+	}
+	// root infos out of the retention window are not synced again
+	if pruned, err := isPruned(s, chainID, rootInfo.Height); err != nil {
+		return nil, fmt.Errorf("isPruned error: %v", err)
+	} else if pruned {
+		return nil, nil
+	}
+	if headers != nil {
+		synced, err := headers.verify(s, rootInfo)
+		if err != nil {
+			return nil, fmt.Errorf("verify header error: %v", err)
+		}
+		if synced {
+			return nil, nil
+		}
+	}
+	return rootInfo, nil
+}
+
+// commitRootInfo stores a root info that reached the voter quorum. A header is only committed on top of
+// the stored tip, so the stored header chain has no holes
+func commitRootInfo(s *contract.ModuleContract, chainID uint64, headers *headerChain, rootInfo *RootInfo) error {
+	if headers != nil {
+		tip, ok, err := GetHeaderTip(s, chainID)
+		if err != nil {
+			return fmt.Errorf("GetHeaderTip error: %v", err)
+		}
+		if ok && rootInfo.Height != tip+1 {
+			return fmt.Errorf("header at height %d does not extend the stored tip %d", rootInfo.Height, tip)
+		}
+	}
+	if err := PutRootInfo(s, chainID, rootInfo.Height, rootInfo.Info); err != nil {
+		return fmt.Errorf("PutCrossChainInfo error: %v", err)
+	}
+	if headers != nil {
+		if err := putHeaderTip(s, chainID, rootInfo.Height); err != nil {
+			return fmt.Errorf("putHeaderTip error: %v", err)
+		}
+	}
+	return nil
+}
+
 func Replenish(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &ReplenishParam{}
 	if err := contract.UnpackMethod(ABI, MethodReplenish, params, ctx.Payload); err != nil {
 		return nil, fmt.Errorf("Replenish, unpack params error: %s", err)
 	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
+	}
 
-	err := NotifyReplenish(s, params.Heights, params.ChainID)
+	heights := make([]uint64, 0, len(params.Heights))
+	for _, height := range params.Heights {
+		heights = append(heights, uint64(height))
+	}
+	heights, err := filterReplenishHeights(s, params.ChainID, heights)
+	if err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
+	}
+	if len(heights) > 0 {
+		requested := make([]uint32, 0, len(heights))
+		for _, height := range heights {
+			requested = append(requested, uint32(height))
+		}
+		if err := NotifyReplenish(s, requested, params.ChainID); err != nil {
+			return nil, fmt.Errorf("Replenish, NotifyReplenish error: %s", err)
+		}
+	}
+	progress, err := fillsGap(s, params.ChainID, heights)
 	if err != nil {
-		return nil, fmt.Errorf("Replenish, NotifyReplenish error: %s", err)
+		return nil, fmt.Errorf("Replenish, %v", err)
+	}
+	if err := relayer_manager.RecordSubmission(s, relayer, progress); err != nil {
+		return nil, fmt.Errorf("Replenish, %v", err)
 	}
 	return contract.PackOutputs(ABI, MethodReplenish, true)
 }
 
+func Replenish64(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &Replenish64Param{}
+	if err := contract.UnpackMethod(ABI, MethodReplenish64, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("Replenish64, unpack params error: %s", err)
+	}
+	relayer := s.ContractRef().TxOrigin()
+	if err := relayer_manager.CheckRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("Replenish64, %v", err)
+	}
+
+	heights, err := filterReplenishHeights(s, params.ChainID, params.Heights)
+	if err != nil {
+		return nil, fmt.Errorf("Replenish64, %v", err)
+	}
+	if len(heights) > 0 {
+		if err := NotifyReplenish64(s, heights, params.ChainID); err != nil {
+			return nil, fmt.Errorf("Replenish64, NotifyReplenish64 error: %s", err)
+		}
+	}
+	progress, err := fillsGap(s, params.ChainID, heights)
+	if err != nil {
+		return nil, fmt.Errorf("Replenish64, %v", err)
+	}
+	if err := relayer_manager.RecordSubmission(s, relayer, progress); err != nil {
+		return nil, fmt.Errorf("Replenish64, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodReplenish64, true)
+}
+
+// filterReplenishHeights returns the heights a replenish call may request and records them. Heights that are
+// synced or pruned, repeated in the call or requested in the last REPLENISH_WINDOW blocks are dropped. The records
+// are deleted once the height is synced or pruned, so heights more than MAX_REPLENISH_AHEAD above the current
+// height of the chain are refused
+func filterReplenishHeights(s *contract.ModuleContract, chainID uint64, heights []uint64) ([]uint64, error) {
+	if len(heights) == 0 || len(heights) > MAX_REPLENISH_HEIGHTS {
+		return nil, fmt.Errorf("invalid replenish height length, min 1, max %d, current %v", MAX_REPLENISH_HEIGHTS, len(heights))
+	}
+	synced, err := GetCurrentHeight(s, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetCurrentHeight error: %v", err)
+	}
+	current := s.ContractRef().BlockHeight().Uint64()
+	requested := make([]uint64, 0, len(heights))
+	for _, height := range heights {
+		if height > synced+MAX_REPLENISH_AHEAD {
+			return nil, fmt.Errorf("height %d is more than %d above the current height %d", height, MAX_REPLENISH_AHEAD, synced)
+		}
+		if containsHeight(requested, height) {
+			continue
+		}
+		info, err := GetRootInfo(s, chainID, height)
+		if errors.Is(err, ErrRootInfoPruned) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("GetRootInfo error: %v", err)
+		}
+		if info != nil {
+			continue
+		}
+		last, ok, err := getReplenishHeight(s, chainID, height)
+		if err != nil {
+			return nil, err
+		}
+		if ok && current < last+REPLENISH_WINDOW {
+			continue
+		}
+		if err := putReplenishHeight(s, chainID, height, current); err != nil {
+			return nil, err
+		}
+		requested = append(requested, height)
+	}
+	return requested, nil
+}
+
+// fillsGap reports whether a replenish call requested a height at or below the current height of the chain.
+// Only such heights are gaps in the synced root infos, heights above it are synced in order anyway and a call
+// requesting nothing else earns no reward
+func fillsGap(s *contract.ModuleContract, chainID uint64, heights []uint64) (bool, error) {
+	synced, err := GetCurrentHeight(s, chainID)
+	if err != nil {
+		return false, fmt.Errorf("GetCurrentHeight error: %v", err)
+	}
+	for _, height := range heights {
+		if height <= synced {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetInfoHeight returns the current height of chains below math.MaxUint32, getInfoHeight64 has to be used above
 func GetInfoHeight(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &GetInfoHeightParam{}
@@ -138,7 +497,24 @@ func GetInfoHeight(s *contract.ModuleContract) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return contract.PackOutputs(ABI, MethodGetInfoHeight, height)
+	if height > math.MaxUint32 {
+		return nil, fmt.Errorf("GetInfoHeight, height %d exceeds uint32, use %s", height, MethodGetInfoHeight64)
+	}
+	return contract.PackOutputs(ABI, MethodGetInfoHeight, uint32(height))
+}
+
+func GetInfoHeight64(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetInfoHeight64Param{}
+	if err := contract.UnpackMethod(ABI, MethodGetInfoHeight64, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	height, err := GetCurrentHeight(s, params.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(ABI, MethodGetInfoHeight64, height)
 }
 
 func GetInfo(s *contract.ModuleContract) ([]byte, error) {
@@ -147,9 +523,138 @@ func GetInfo(s *contract.ModuleContract) ([]byte, error) {
 	if err := contract.UnpackMethod(ABI, MethodGetInfo, params, ctx.Payload); err != nil {
 		return nil, err
 	}
-	info, err := GetRootInfo(s, params.ChainID, params.Height)
+	info, err := GetRootInfo(s, params.ChainID, uint64(params.Height))
 	if err != nil {
 		return nil, err
 	}
 	return contract.PackOutputs(ABI, MethodGetInfo, info)
 }
+
+func GetInfo64(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetInfo64Param{}
+	if err := contract.UnpackMethod(ABI, MethodGetInfo64, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	info, err := GetRootInfo(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, err
+	}
+	return contract.PackOutputs(ABI, MethodGetInfo64, info)
+}
+
+// GetStateRoot returns the state root indexed from the synced header of an evm chain at a height
+func GetStateRoot(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetStateRootParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetStateRoot, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	fields, err := getSyncedHeaderFields(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetStateRoot, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetStateRoot, fields.StateRoot)
+}
+
+// GetReceiptsRoot returns the receipts root indexed from the synced header of an evm chain at a height
+func GetReceiptsRoot(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetReceiptsRootParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetReceiptsRoot, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	fields, err := getSyncedHeaderFields(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetReceiptsRoot, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetReceiptsRoot, fields.ReceiptsRoot)
+}
+
+// GetHeaderFields returns all fields indexed from the synced header of an evm chain at a height
+func GetHeaderFields(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetHeaderFieldsParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetHeaderFields, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	fields, err := getSyncedHeaderFields(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetHeaderFields, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetHeaderFields, fields.StateRoot, fields.ReceiptsRoot, fields.TransactionsRoot,
+		fields.BlockHash, fields.Timestamp)
+}
+
+// getSyncedHeaderFields returns the header fields of a synced height of an evm chain
+func getSyncedHeaderFields(s *contract.ModuleContract, chainID, height uint64) (*HeaderFields, error) {
+	sideChain, err := side_chain_manager.GetSideChainObject(s, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("side_chain_manager.GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil || !hasHeaderFields(sideChain) {
+		return nil, fmt.Errorf("chain %d does not sync evm headers", chainID)
+	}
+	fields, err := GetHeaderFieldsObj(s, chainID, height)
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("root info of height %d is not synced", height)
+	}
+	return fields, nil
+}
+
+// GetInfoRange returns the heights in [from, to] that have a root info along with the infos
+func GetInfoRange(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetInfoRangeParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetInfoRange, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	heights, infos, _, err := scanRootInfos(s, params.ChainID, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("GetInfoRange, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetInfoRange, heights, infos)
+}
+
+// GetMissingHeights returns the heights in [from, to] without a root info that can still be synced,
+// pruned heights are not missing since they are never synced again
+func GetMissingHeights(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetMissingHeightsParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetMissingHeights, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	_, _, missing, err := scanRootInfos(s, params.ChainID, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("GetMissingHeights, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetMissingHeights, missing)
+}
+
+// GetSyncProgress returns the voters that voted for a root info so far and the voter quorum of the current epoch,
+// votes are kept until the height is pruned
+func GetSyncProgress(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetSyncProgressParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetSyncProgress, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	votes, err := getRootInfoVotes(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetSyncProgress, getRootInfoVotes error: %v", err)
+	}
+	voters := make([]common.Address, 0, len(votes))
+	for _, vote := range votes {
+		if vote.InfoHash == params.InfoHash {
+			voters = append(voters, vote.Voter)
+		}
+	}
+	epoch, err := node_manager.GetCurrentEpochInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetSyncProgress, node_manager.GetCurrentEpochInfoImpl error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetSyncProgress, voters, uint64(epoch.VoterQuorumSize()))
+}
@@ -20,13 +20,18 @@ package info_sync
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"log"
+	"math"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
@@ -190,3 +195,655 @@ func TestReplenish(t *testing.T) {
 	_, err = contract.TestModuleCall(t, cfg.InfoSyncContractAddress, "Replenish", input, new(big.Int), extra, sdb)
 	assert.Nil(t, err)
 }
+
+func TestHeaderSyncRootInfo(t *testing.T) {
+	Init()
+	chainID := uint64(2)
+	contractRef := contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil)
+	c := contract.NewModuleContract(sdb, contractRef)
+	extraInfo, err := rlp.EncodeToBytes(&side_chain_manager.EthExtraInfo{HeaderSync: true})
+	assert.Nil(t, err)
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{
+		Router:    common2.ETH_COMMON_ROUTER,
+		ChainID:   chainID,
+		ExtraInfo: extraInfo,
+	}))
+
+	newHeader := func(parent *types.Header, number int64) *types.Header {
+		header := &types.Header{Number: big.NewInt(number), Time: uint64(number), GasLimit: 8000000, Difficulty: big.NewInt(1)}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+		}
+		return header
+	}
+	toRootInfos := func(headers ...*types.Header) []*RootInfo {
+		rootInfos := make([]*RootInfo, 0, len(headers))
+		for _, header := range headers {
+			info, err := rlp.EncodeToBytes(header)
+			assert.Nil(t, err)
+			rootInfos = append(rootInfos, &RootInfo{Height: header.Number.Uint64(), Info: info})
+		}
+		return rootInfos
+	}
+	sync := func(voters int, headers ...*types.Header) error {
+		rootInfos := toRootInfos(headers...)
+		for i := 0; i < voters; i++ {
+			if err := callSyncRootInfo(i, chainID, rootInfos...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	h10 := newHeader(nil, 10)
+	h11 := newHeader(h10, 11)
+	assert.Nil(t, sync(testGenesisNum-1, h10, h11))
+	// a call with headers that are all accepted casts no vote
+	assert.NotNil(t, sync(1, h10, h11))
+	tip, ok, err := GetHeaderTip(c, chainID)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(11), tip)
+
+	// headers not linked to the tip are refused before voting
+	assert.NotNil(t, sync(1, newHeader(h10, 12)))
+	assert.NotNil(t, sync(1, newHeader(h11, 13)))
+	forked := newHeader(h10, 11)
+	forked.Time = 20
+	assert.NotNil(t, sync(1, forked))
+
+	// an accepted header is skipped so the next one can be voted in the same call
+	h12 := newHeader(h11, 12)
+	assert.Nil(t, sync(testGenesisNum-1, h11, h12))
+	tip, _, err = GetHeaderTip(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), tip)
+
+	// a header is only committed on top of the stored tip, so no header is stored above a hole
+	h13 := newHeader(h12, 13)
+	h14 := newHeader(h13, 14)
+	assert.NotNil(t, commitRootInfo(c, chainID, &headerChain{chainID: chainID}, toRootInfos(h14)[0]))
+	info, err := GetRootInfo(c, chainID, 14)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+	assert.Nil(t, callSyncRootInfoAggregated(testGenesisPri[:testGenesisNum-1], chainID, toRootInfos(h13, h14)...))
+	tip, _, err = GetHeaderTip(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(14), tip)
+
+	// clique headers have to be sealed by a configured sealer
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	sealer := crypto.PubkeyToAddress(key.PublicKey)
+	header := newHeader(h14, 15)
+	header.Extra = make([]byte, EXTRA_VANITY+EXTRA_SEAL)
+	assert.NotNil(t, verifyHeaderSeal(header, []common.Address{sealer}))
+	sig, err := crypto.Sign(clique.SealHash(header).Bytes(), key)
+	assert.Nil(t, err)
+	copy(header.Extra[EXTRA_VANITY:], sig)
+	assert.Nil(t, verifyHeaderSeal(header, []common.Address{sealer}))
+	assert.NotNil(t, verifyHeaderSeal(header, []common.Address{testGenesisPeers[0]}))
+}
+
+func callSyncRootInfo(voter int, chainID uint64, rootInfos ...*RootInfo) error {
+	param := &SyncRootInfoParam{ChainID: chainID}
+	for _, rootInfo := range rootInfos {
+		blob, err := rlp.EncodeToBytes(rootInfo)
+		if err != nil {
+			return err
+		}
+		param.RootInfos = append(param.RootInfos, blob)
+	}
+	digest, err := param.Digest()
+	if err != nil {
+		return err
+	}
+	if param.Signature, err = crypto.Sign(digest, testGenesisPri[voter]); err != nil {
+		return err
+	}
+	input, err := param.Encode()
+	if err != nil {
+		return err
+	}
+	caller := testGenesisPeers[voter]
+	ref := contract.NewContractRef(sdb, caller, caller, big.NewInt(1), common.Hash{}, uint64(21000000000000), nil)
+	_, _, err = ref.ModuleCall(caller, cfg.InfoSyncContractAddress, input)
+	return err
+}
+
+// getEquivocation queries node_manager for the equivocation evidence of a voter reported at block height 1
+func getEquivocation(t *testing.T, c *contract.ModuleContract, voter common.Address) *node_manager.Evidence {
+	input, err := (&node_manager.GetEvidenceParam{voter, cfg.InfoSyncContractAddress, EVIDENCE_ROOT_INFO_EQUIVOCATION, 1}).Encode()
+	assert.Nil(t, err)
+	raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.NodeManagerContractAddress, input)
+	assert.Nil(t, err)
+	evidence := new(node_manager.Evidence)
+	if err := evidence.Decode(raw); err != nil {
+		return nil
+	}
+	return evidence
+}
+
+func TestRootInfoConflict(t *testing.T) {
+	Init()
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	infoA := &RootInfo{Height: 5, Info: []byte{0x0a}}
+	infoB := &RootInfo{Height: 5, Info: []byte{0x0b}, Round: 1}
+
+	// a voter may replace its vote before the quorum with one of a later round, the replaced vote is not counted
+	// any more and can not be replayed to take the replacement back
+	assert.Nil(t, callSyncRootInfo(0, CHAIN_ID, infoA))
+	assert.NotNil(t, callSyncRootInfo(0, CHAIN_ID, &RootInfo{Height: 5, Info: infoB.Info}))
+	assert.Nil(t, callSyncRootInfo(0, CHAIN_ID, infoB))
+	assert.NotNil(t, callSyncRootInfo(0, CHAIN_ID, infoA))
+	for i := 1; i < testGenesisNum-1; i++ {
+		assert.Nil(t, callSyncRootInfo(i, CHAIN_ID, infoA))
+	}
+	info, err := GetRootInfo(c, CHAIN_ID, 5)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+	assert.Nil(t, getEquivocation(t, c, testGenesisPeers[0]))
+	assert.Nil(t, callSyncRootInfo(0, CHAIN_ID, &RootInfo{Height: 5, Info: infoA.Info, Round: 2}))
+	info, err = GetRootInfo(c, CHAIN_ID, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, infoA.Info, info)
+
+	// a voter signing another info after its vote was finalised is reported once, both signatures are kept
+	assert.Nil(t, callSyncRootInfo(0, CHAIN_ID, infoB))
+	assert.NotNil(t, callSyncRootInfo(0, CHAIN_ID, &RootInfo{Height: 5, Info: []byte{0x0c}}))
+	evidence := getEquivocation(t, c, testGenesisPeers[0])
+	assert.NotNil(t, evidence)
+	equivocation := new(Equivocation)
+	assert.Nil(t, rlp.DecodeBytes(evidence.Data, equivocation))
+	assert.Equal(t, crypto.Keccak256Hash(infoA.Info), equivocation.First.InfoHash)
+	assert.Equal(t, crypto.Keccak256Hash(infoB.Info), equivocation.Second.InfoHash)
+	for _, vote := range []*RootInfoVote{equivocation.First, equivocation.Second} {
+		digest, err := vote.Digest(CHAIN_ID)
+		assert.Nil(t, err)
+		pub, err := crypto.SigToPub(digest, vote.Signature)
+		assert.Nil(t, err)
+		assert.Equal(t, testGenesisPeers[0], crypto.PubkeyToAddress(*pub))
+	}
+
+	// a vote against the finalised info is refused without evidence
+	assert.NotNil(t, callSyncRootInfo(testGenesisNum-1, CHAIN_ID, infoB))
+	info, err = GetRootInfo(c, CHAIN_ID, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, infoA.Info, info)
+	assert.Nil(t, getEquivocation(t, c, testGenesisPeers[testGenesisNum-1]))
+	assert.NotNil(t, PutRootInfo(c, CHAIN_ID, 5, infoB.Info))
+}
+
+func TestRootInfoRetention(t *testing.T) {
+	Init()
+	chainID := uint64(3)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{Router: common2.NO_PROOF_ROUTER, ChainID: chainID}))
+	vote := func(param interface{ Encode() ([]byte, error) }) error {
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		for _, signer := range testGenesisPeers {
+			ref := contract.NewContractRef(sdb, signer, signer, big.NewInt(1), common.Hash{}, uint64(21000000000000), nil)
+			if _, _, err := ref.ModuleCall(signer, cfg.InfoSyncContractAddress, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	sync := func(from, to uint64) {
+		for height := from; height <= to; height++ {
+			for i := 0; i < testGenesisNum; i++ {
+				assert.Nil(t, callSyncRootInfo(i, chainID, &RootInfo{Height: height, Info: []byte{byte(height)}}))
+			}
+		}
+	}
+	sync(1, 10)
+
+	assert.Nil(t, vote(&SetRetentionParam{ChainID: chainID, Window: 3}))
+	assert.Nil(t, vote(&PinRootInfoParam{ChainID: chainID, Height: 2, Pinned: true}))
+	retention, err := GetRetentionObj(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), retention.Window)
+	assert.Equal(t, uint64(2), retention.Revision)
+
+	assert.Nil(t, PruneRootInfo(c))
+	prunedHeight, err := getPrunedHeight(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), prunedHeight)
+	for height := uint64(1); height <= 10; height++ {
+		info, err := GetRootInfo(c, chainID, height)
+		if height < 7 && height != 2 {
+			assert.True(t, errors.Is(err, ErrRootInfoPruned), "height %d", height)
+			assert.Nil(t, info)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, []byte{byte(height)}, info)
+		}
+	}
+
+	// pruned heights are not synced again and can not be pinned any more
+	assert.NotNil(t, callSyncRootInfo(0, chainID, &RootInfo{Height: 5, Info: []byte{5}}))
+	_, err = GetRootInfo(c, chainID, 5)
+	assert.True(t, errors.Is(err, ErrRootInfoPruned))
+	assert.NotNil(t, vote(&PinRootInfoParam{ChainID: chainID, Height: 5, Pinned: true}))
+
+	// unpinning a height below the pruned height prunes it at once
+	assert.Nil(t, vote(&PinRootInfoParam{ChainID: chainID, Height: 2, Pinned: false}))
+	_, err = GetRootInfo(c, chainID, 2)
+	assert.True(t, errors.Is(err, ErrRootInfoPruned))
+
+	// pruning follows the current height and stops once the window is removed
+	sync(11, 12)
+	assert.Nil(t, PruneRootInfo(c))
+	_, err = GetRootInfo(c, chainID, 8)
+	assert.True(t, errors.Is(err, ErrRootInfoPruned))
+	assert.Nil(t, vote(&SetRetentionParam{ChainID: chainID, Window: 0}))
+	sync(13, 14)
+	assert.Nil(t, PruneRootInfo(c))
+	output := new(GetRetentionOutput)
+	input, err := (&GetRetentionParam{ChainID: chainID}).Encode()
+	assert.Nil(t, err)
+	raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+	assert.Nil(t, err)
+	assert.Nil(t, output.Decode(raw))
+	assert.Equal(t, uint64(0), output.Window)
+	assert.Equal(t, uint64(9), output.PrunedHeight)
+}
+
+func TestInfoRangeQuery(t *testing.T) {
+	Init()
+	chainID := uint64(4)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{Router: common2.NO_PROOF_ROUTER, ChainID: chainID}))
+	for _, height := range []uint64{1, 2, 4, 5, 8, 9} {
+		assert.Nil(t, PutRootInfo(c, chainID, height, []byte{byte(height)}))
+	}
+	query := func(param interface{ Encode() ([]byte, error) }, output interface{ Decode([]byte) error }) error {
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+		if err != nil {
+			return err
+		}
+		return output.Decode(raw)
+	}
+
+	infoRange := new(GetInfoRangeOutput)
+	assert.Nil(t, query(&GetInfoRangeParam{chainID, 2, 8}, infoRange))
+	assert.Equal(t, []uint64{2, 4, 5, 8}, infoRange.Heights)
+	assert.Equal(t, [][]byte{{2}, {4}, {5}, {8}}, infoRange.Infos)
+	missing := new(GetMissingHeightsOutput)
+	assert.Nil(t, query(&GetMissingHeightsParam{chainID, 0, 10}, missing))
+	assert.Equal(t, []uint64{0, 3, 6, 7, 10}, missing.Heights)
+
+	// pruned heights are neither present nor missing
+	assert.Nil(t, putRetention(c, chainID, &Retention{Window: 5}))
+	assert.Nil(t, addRetentionIndex(c, chainID))
+	assert.Nil(t, PruneRootInfo(c))
+	assert.Nil(t, query(&GetInfoRangeParam{chainID, 0, 10}, infoRange))
+	assert.Equal(t, []uint64{4, 5, 8, 9}, infoRange.Heights)
+	assert.Nil(t, query(&GetMissingHeightsParam{chainID, 0, 10}, missing))
+	assert.Equal(t, []uint64{6, 7, 10}, missing.Heights)
+
+	assert.NotNil(t, query(&GetInfoRangeParam{chainID, 5, 4}, infoRange))
+	assert.NotNil(t, query(&GetMissingHeightsParam{chainID, 0, MAX_QUERY_RANGE}, missing))
+	assert.Nil(t, query(&GetMissingHeightsParam{chainID, 1, MAX_QUERY_RANGE}, missing))
+}
+
+func TestSyncProgress(t *testing.T) {
+	Init()
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	rootInfo := &RootInfo{Height: 7, Info: []byte{0x07}}
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, callSyncRootInfo(i, CHAIN_ID, rootInfo))
+	}
+	assert.Nil(t, callSyncRootInfo(2, CHAIN_ID, &RootInfo{Height: 7, Info: []byte{0x08}}))
+
+	input, err := (&GetSyncProgressParam{CHAIN_ID, 7, crypto.Keccak256Hash(rootInfo.Info)}).Encode()
+	assert.Nil(t, err)
+	raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+	assert.Nil(t, err)
+	output := new(GetSyncProgressOutput)
+	assert.Nil(t, output.Decode(raw))
+	assert.Equal(t, testGenesisPeers[:2], output.Voters)
+	assert.Equal(t, uint64(3), output.Quorum)
+
+	// the generic query of node_manager sees the same signers of the consensus sign
+	blob, err := rlp.EncodeToBytes(&RootInfoUnique{CHAIN_ID, rootInfo.Height, rootInfo.Info})
+	assert.Nil(t, err)
+	input, err = (&node_manager.GetSignProgressParam{Method: MethodSyncRootInfo, InputHash: crypto.Keccak256Hash(blob)}).Encode()
+	assert.Nil(t, err)
+	raw, _, err = c.ContractRef().ModuleCall(common.EmptyAddress, cfg.NodeManagerContractAddress, input)
+	assert.Nil(t, err)
+	progress := new(node_manager.SignProgress)
+	assert.Nil(t, progress.Decode(raw))
+	assert.Equal(t, testGenesisPeers[:2], progress.Signers)
+	assert.Equal(t, uint64(3), progress.Quorum)
+
+	input, err = (&node_manager.GetSignProgressParam{Method: MethodSyncRootInfo, InputHash: common.Hash{}}).Encode()
+	assert.Nil(t, err)
+	_, _, err = c.ContractRef().ModuleCall(common.EmptyAddress, cfg.NodeManagerContractAddress, input)
+	assert.NotNil(t, err)
+}
+
+func TestRootInfoHeight64(t *testing.T) {
+	Init()
+	chainID := uint64(5)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{Router: common2.NO_PROOF_ROUTER, ChainID: chainID}))
+	query := func(param interface{ Encode() ([]byte, error) }, output interface{ Decode([]byte) error }) error {
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+		if err != nil {
+			return err
+		}
+		return output.Decode(raw)
+	}
+
+	// a chain synced below math.MaxUint32 keeps working with the uint32 methods
+	low, high := uint64(math.MaxUint32), uint64(math.MaxUint32)+2
+	for i := 0; i < testGenesisNum; i++ {
+		assert.Nil(t, callSyncRootInfo(i, chainID, &RootInfo{Height: low, Info: []byte{1}}))
+	}
+	height := new(GetInfoHeightOutput)
+	assert.Nil(t, query(&GetInfoHeightParam{chainID}, height))
+	assert.Equal(t, uint32(math.MaxUint32), height.Height)
+	info := new(GetInfoOutput)
+	assert.Nil(t, query(&GetInfoParam{chainID, math.MaxUint32}, info))
+	assert.Equal(t, []byte{1}, info.Info)
+
+	// and moves on to the uint64 ones once it passes it
+	for i := 0; i < testGenesisNum; i++ {
+		assert.Nil(t, callSyncRootInfo(i, chainID, &RootInfo{Height: high, Info: []byte{2}}))
+	}
+	assert.NotNil(t, query(&GetInfoHeightParam{chainID}, height))
+	height64 := new(GetInfoHeight64Output)
+	assert.Nil(t, query(&GetInfoHeight64Param{chainID}, height64))
+	assert.Equal(t, high, height64.Height)
+	info64 := new(GetInfo64Output)
+	assert.Nil(t, query(&GetInfo64Param{chainID, high}, info64))
+	assert.Equal(t, []byte{2}, info64.Info)
+	assert.Nil(t, query(&GetInfo64Param{chainID, low}, info64))
+	assert.Equal(t, []byte{1}, info64.Info)
+	// the height above does not alias the uint32 key of its low bits
+	raw, err := GetRootInfo(c, chainID, high&math.MaxUint32)
+	assert.Nil(t, err)
+	assert.Nil(t, raw)
+
+	missing := new(GetMissingHeightsOutput)
+	assert.Nil(t, query(&GetMissingHeightsParam{chainID, low, high}, missing))
+	assert.Equal(t, []uint64{low + 1}, missing.Heights)
+	lowest, err := GetLowestHeight(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, low, lowest)
+}
+
+func callSyncRootInfoAggregated(keys []*ecdsa.PrivateKey, chainID uint64, rootInfos ...*RootInfo) error {
+	param := &SyncRootInfoAggregatedParam{ChainID: chainID}
+	for _, rootInfo := range rootInfos {
+		blob, err := rlp.EncodeToBytes(rootInfo)
+		if err != nil {
+			return err
+		}
+		param.RootInfos = append(param.RootInfos, blob)
+	}
+	digest, err := param.Digest()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		sig, err := crypto.Sign(digest, key)
+		if err != nil {
+			return err
+		}
+		param.Signatures = append(param.Signatures, sig)
+	}
+	input, err := param.Encode()
+	if err != nil {
+		return err
+	}
+	caller := common.EmptyAddress
+	ref := contract.NewContractRef(sdb, caller, caller, big.NewInt(1), common.Hash{}, uint64(21000000000000), nil)
+	_, _, err = ref.ModuleCall(caller, cfg.InfoSyncContractAddress, input)
+	return err
+}
+
+func TestAggregatedSyncRootInfo(t *testing.T) {
+	Init()
+	chainID := uint64(6)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{Router: common2.NO_PROOF_ROUTER, ChainID: chainID}))
+	info10 := &RootInfo{Height: 10, Info: []byte{0x10}}
+	info11 := &RootInfo{Height: 11, Info: []byte{0x11}, Round: 1}
+
+	// the signatures have to come from a quorum of distinct voters
+	assert.NotNil(t, callSyncRootInfoAggregated(testGenesisPri[:2], chainID, info10))
+	assert.NotNil(t, callSyncRootInfoAggregated([]*ecdsa.PrivateKey{testGenesisPri[0], testGenesisPri[1], testGenesisPri[1]}, chainID, info10))
+	assert.NotNil(t, callSyncRootInfoAggregated([]*ecdsa.PrivateKey{testGenesisPri[0], testGenesisPri[1], key}, chainID, info10))
+	info, err := GetRootInfo(c, chainID, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+
+	// a voter that signed another info of a height before the quorum replaces its vote
+	assert.Nil(t, callSyncRootInfo(0, chainID, &RootInfo{Height: 11, Info: []byte{0x12}}))
+	assert.Nil(t, callSyncRootInfoAggregated(testGenesisPri[:testGenesisNum], chainID, info10, info11))
+	info, err = GetRootInfo(c, chainID, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, info10.Info, info)
+	info, err = GetRootInfo(c, chainID, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, info11.Info, info)
+	assert.Nil(t, getEquivocation(t, c, testGenesisPeers[0]))
+
+	// the votes are recorded as if each voter had synced on its own
+	input, err := (&GetSyncProgressParam{chainID, 10, crypto.Keccak256Hash(info10.Info)}).Encode()
+	assert.Nil(t, err)
+	raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+	assert.Nil(t, err)
+	output := new(GetSyncProgressOutput)
+	assert.Nil(t, output.Decode(raw))
+	assert.Equal(t, testGenesisPeers[:testGenesisNum], output.Voters)
+
+	// replaying a batch already committed or a vote already cast is refused
+	assert.NotNil(t, callSyncRootInfoAggregated(testGenesisPri[1:testGenesisNum], chainID, info10, info11))
+	assert.NotNil(t, callSyncRootInfo(1, chainID, info10))
+	height, err := GetCurrentHeight(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(11), height)
+}
+
+func TestHeaderFields(t *testing.T) {
+	Init()
+	chainID := uint64(7)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	extraInfo, err := rlp.EncodeToBytes(&side_chain_manager.EthExtraInfo{})
+	assert.Nil(t, err)
+	assert.Nil(t, side_chain_manager.PutSideChain(c, &side_chain_manager.SideChain{
+		Router:    common2.ETH_COMMON_ROUTER,
+		ChainID:   chainID,
+		ExtraInfo: extraInfo,
+	}))
+	query := func(param interface{ Encode() ([]byte, error) }, output interface{ Decode([]byte) error }) error {
+		input, err := param.Encode()
+		assert.Nil(t, err)
+		raw, _, err := c.ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+		if err != nil {
+			return err
+		}
+		return output.Decode(raw)
+	}
+
+	// json headers of eth_getBlockByNumber are indexed when synced
+	hash, stateRoot, receiptsRoot, txRoot := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03"), common.HexToHash("0x04")
+	info := []byte(`{"hash":"` + hash.Hex() + `","stateRoot":"` + stateRoot.Hex() + `","receiptsRoot":"` + receiptsRoot.Hex() +
+		`","transactionsRoot":"` + txRoot.Hex() + `","timestamp":"0x10"}`)
+	for i := 0; i < testGenesisNum; i++ {
+		assert.Nil(t, callSyncRootInfo(i, chainID, &RootInfo{Height: 1, Info: info}))
+	}
+	stateRootOutput := new(GetStateRootOutput)
+	assert.Nil(t, query(&GetStateRootParam{chainID, 1}, stateRootOutput))
+	assert.Equal(t, stateRoot, stateRootOutput.StateRoot)
+	receiptsRootOutput := new(GetReceiptsRootOutput)
+	assert.Nil(t, query(&GetReceiptsRootParam{chainID, 1}, receiptsRootOutput))
+	assert.Equal(t, receiptsRoot, receiptsRootOutput.ReceiptsRoot)
+	fields := new(GetHeaderFieldsOutput)
+	assert.Nil(t, query(&GetHeaderFieldsParam{chainID, 1}, fields))
+	assert.Equal(t, &GetHeaderFieldsOutput{stateRoot, receiptsRoot, txRoot, hash, 16}, fields)
+	assert.NotNil(t, query(&GetStateRootParam{chainID, 2}, stateRootOutput))
+	assert.NotNil(t, query(&GetStateRootParam{CHAIN_ID, 1}, stateRootOutput))
+
+	// root infos that are no evm header are refused before voting
+	assert.NotNil(t, callSyncRootInfo(0, chainID, &RootInfo{Height: 2, Info: []byte(`{}`)}))
+	assert.NotNil(t, callSyncRootInfo(0, chainID, &RootInfo{Height: 2, Info: []byte{0x01}}))
+
+	// root infos synced before the fields were indexed are decoded when read
+	assert.Nil(t, c.GetCacheDB().Put(rootInfoKey(chainID, 3), info))
+	indexed, err := GetHeaderFieldsObj(c, chainID, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, stateRoot, indexed.StateRoot)
+
+	// the hash of rlp headers is computed
+	header := &types.Header{Number: big.NewInt(4), Root: stateRoot, ReceiptHash: receiptsRoot, Time: 4, Difficulty: big.NewInt(1)}
+	blob, err := rlp.EncodeToBytes(header)
+	assert.Nil(t, err)
+	decoded, err := DecodeHeaderFields(blob)
+	assert.Nil(t, err)
+	assert.Equal(t, &HeaderFields{stateRoot, receiptsRoot, common.Hash{}, header.Hash(), 4}, decoded)
+}
+
+func TestReplenishWindow(t *testing.T) {
+	Init()
+	chainID := uint64(8)
+	at := func(blockHeight int64) *contract.ModuleContract {
+		return contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(blockHeight), common.Hash{}, 0, nil))
+	}
+	assert.Nil(t, PutRootInfo(at(1), chainID, 11, []byte{0x11}))
+
+	// synced and repeated heights are dropped
+	heights, err := filterReplenishHeights(at(1), chainID, []uint64{9, 9, 10, 11})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{9, 10}, heights)
+
+	// a height is requested again once the window passed
+	heights, err = filterReplenishHeights(at(REPLENISH_WINDOW), chainID, []uint64{9, 10, 12})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{12}, heights)
+	heights, err = filterReplenishHeights(at(REPLENISH_WINDOW+1), chainID, []uint64{9, 10, 12})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{9, 10}, heights)
+
+	// the record of a height is deleted once it is synced
+	assert.Nil(t, PutRootInfo(at(1), chainID, 9, []byte{0x09}))
+	_, ok, err := getReplenishHeight(at(1), chainID, 9)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	_, ok, err = getReplenishHeight(at(1), chainID, 10)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// heights too far above the current height are refused
+	heights, err = filterReplenishHeights(at(1), chainID, []uint64{11 + MAX_REPLENISH_AHEAD})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{11 + MAX_REPLENISH_AHEAD}, heights)
+	_, err = filterReplenishHeights(at(1), chainID, []uint64{12 + MAX_REPLENISH_AHEAD})
+	assert.NotNil(t, err)
+
+	// only heights at or below the current height fill a gap
+	gap, err := fillsGap(at(1), chainID, []uint64{10, 12})
+	assert.Nil(t, err)
+	assert.True(t, gap)
+	gap, err = fillsGap(at(1), chainID, []uint64{12, 11 + MAX_REPLENISH_AHEAD})
+	assert.Nil(t, err)
+	assert.False(t, gap)
+
+	_, err = filterReplenishHeights(at(1), chainID, nil)
+	assert.NotNil(t, err)
+	input, err := (&Replenish64Param{chainID, make([]uint64, MAX_REPLENISH_HEIGHTS+1)}).Encode()
+	assert.Nil(t, err)
+	_, _, err = at(1).ContractRef().ModuleCall(common.EmptyAddress, cfg.InfoSyncContractAddress, input)
+	assert.NotNil(t, err)
+}
+
+func TestCleanRootInfo(t *testing.T) {
+	Init()
+	chainID := uint64(9)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	// heights 3 and 4 are synced before the stored height queue
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	for _, height := range []uint64{3, 4} {
+		assert.Nil(t, c.GetCacheDB().Put(rootInfoKey(chainID, height), []byte{byte(height)}))
+	}
+	assert.Nil(t, c.GetCacheDB().Put(utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(CURRENT_HEIGHT), chainIDBytes), encodeHeight(4)))
+	assert.Nil(t, c.GetCacheDB().Put(utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(LOWEST_HEIGHT), chainIDBytes), encodeHeight(3)))
+	heights := []uint64{3, 4, 1 << 40, 100}
+	for _, height := range heights[2:] {
+		assert.Nil(t, PutRootInfo(c, chainID, height, []byte{byte(height)}))
+	}
+
+	// the gaps between stored heights cost nothing
+	calls, total := 0, 0
+	for done := false; !done; calls++ {
+		var used int
+		var err error
+		_, used, done, err = CleanRootInfo(c, chainID, 0, 1)
+		assert.Nil(t, err)
+		total += used
+	}
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, len(heights)+7, total)
+	for _, height := range heights {
+		info, err := GetRootInfo(c, chainID, height)
+		assert.Nil(t, err)
+		assert.Nil(t, info)
+	}
+	stored, err := getStoredHeights(c, chainID)
+	assert.Nil(t, err)
+	assert.Nil(t, stored)
+	current, err := GetCurrentHeight(c, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), current)
+}
+
+func TestPruneRotation(t *testing.T) {
+	Init()
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	// chain 10 has twice the backlog of chain 11, its heights are sparse
+	backlogs := map[uint64]uint64{10: 2 * PRUNE_BATCH_SIZE, 11: PRUNE_BATCH_SIZE}
+	for chainID, backlog := range backlogs {
+		for k := uint64(1); k <= backlog+1; k++ {
+			assert.Nil(t, PutRootInfo(c, chainID, k*1000, []byte{byte(k)}))
+		}
+		assert.Nil(t, putRetention(c, chainID, &Retention{Window: 1}))
+		assert.Nil(t, addRetentionIndex(c, chainID))
+	}
+
+	// the first block spends the whole budget on chain 10 and the next one starts from chain 11
+	assert.Nil(t, PruneRootInfo(c))
+	prunedHeight, err := getPrunedHeight(c, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(PRUNE_BATCH_SIZE+1)*1000, prunedHeight)
+	prunedHeight, err = getPrunedHeight(c, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), prunedHeight)
+	assert.Nil(t, PruneRootInfo(c))
+	prunedHeight, err = getPrunedHeight(c, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(PRUNE_BATCH_SIZE+1)*1000-1, prunedHeight)
+	prunedHeight, err = getPrunedHeight(c, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(PRUNE_BATCH_SIZE+1)*1000, prunedHeight)
+
+	assert.Nil(t, PruneRootInfo(c))
+	prunedHeight, err = getPrunedHeight(c, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2*PRUNE_BATCH_SIZE+1)*1000-1, prunedHeight)
+	for k := uint64(1); k <= 2*PRUNE_BATCH_SIZE; k++ {
+		info, err := c.GetCacheDB().Get(rootInfoKey(10, k*1000))
+		assert.Nil(t, err)
+		assert.Nil(t, info)
+	}
+	stored, err := getStoredHeights(c, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, stored.Tail-1, stored.Head)
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package info_sync
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/cfg"
+	common2 "github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+)
+
+// HeaderFields are the fields of an evm header routers verify proofs against, indexed when its root info is synced
+type HeaderFields struct {
+	StateRoot        common.Hash
+	ReceiptsRoot     common.Hash
+	TransactionsRoot common.Hash
+	BlockHash        common.Hash
+	Timestamp        uint64
+}
+
+// hasHeaderFields tells whether root infos of a chain are evm headers
+func hasHeaderFields(sideChain *side_chain_manager.SideChain) bool {
+	return sideChain.Router == common2.ETH_COMMON_ROUTER
+}
+
+// DecodeHeaderFields decodes an evm header root info, either rlp encoded as in header sync mode or the json of
+// eth_getBlockByNumber. The block hash of a json header is its hash field, or computed if it is a full header
+func DecodeHeaderFields(info []byte) (*HeaderFields, error) {
+	header := new(types.Header)
+	if err := rlp.DecodeBytes(info, header); err == nil {
+		return &HeaderFields{header.Root, header.ReceiptHash, header.TxHash, header.Hash(), header.Time}, nil
+	}
+
+	var data struct {
+		Hash             *common.Hash   `json:"hash"`
+		StateRoot        common.Hash    `json:"stateRoot"`
+		ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
+		TransactionsRoot common.Hash    `json:"transactionsRoot"`
+		Timestamp        hexutil.Uint64 `json:"timestamp"`
+	}
+	if err := json.Unmarshal(info, &data); err != nil {
+		return nil, fmt.Errorf("DecodeHeaderFields, decode header error: %v", err)
+	}
+	if data.StateRoot == (common.Hash{}) {
+		return nil, fmt.Errorf("DecodeHeaderFields, header has no state root")
+	}
+	fields := &HeaderFields{data.StateRoot, data.ReceiptsRoot, data.TransactionsRoot, common.Hash{}, uint64(data.Timestamp)}
+	if data.Hash != nil {
+		fields.BlockHash = *data.Hash
+	} else if err := json.Unmarshal(info, header); err == nil {
+		fields.BlockHash = header.Hash()
+	}
+	return fields, nil
+}
+
+// GetHeaderFieldsObj returns the header fields of an evm chain at a height, nil if the height is not synced. Root
+// infos synced before the fields were indexed are decoded on the fly
+func GetHeaderFieldsObj(module *contract.ModuleContract, chainID uint64, height uint64) (*HeaderFields, error) {
+	store, err := module.GetCacheDB().Get(headerFieldsKey(chainID, height))
+	if err != nil {
+		return nil, fmt.Errorf("GetHeaderFieldsObj, module.GetCacheDB().Get error: %v", err)
+	}
+	if store != nil {
+		fields := new(HeaderFields)
+		if err := rlp.DecodeBytes(store, fields); err != nil {
+			return nil, fmt.Errorf("GetHeaderFieldsObj, deserialize header fields error: %v", err)
+		}
+		return fields, nil
+	}
+	info, err := GetRootInfo(module, chainID, height)
+	if err != nil {
+		return nil, fmt.Errorf("GetHeaderFieldsObj, %w", err)
+	}
+	if info == nil {
+		return nil, nil
+	}
+	return DecodeHeaderFields(info)
+}
+
+// indexHeaderFields stores the header fields of a root info committed for an evm chain
+func indexHeaderFields(module *contract.ModuleContract, chainID uint64, height uint64, info []byte) error {
+	sideChain, err := side_chain_manager.GetSideChainObject(module, chainID)
+	if err != nil {
+		return fmt.Errorf("indexHeaderFields, side_chain_manager.GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil || !hasHeaderFields(sideChain) {
+		return nil
+	}
+	fields, err := DecodeHeaderFields(info)
+	if err != nil {
+		return err
+	}
+	blob, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return fmt.Errorf("indexHeaderFields, rlp.EncodeToBytes header fields error: %v", err)
+	}
+	return module.GetCacheDB().Put(headerFieldsKey(chainID, height), blob)
+}
+
+func headerFieldsKey(chainID uint64, height uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(HEADER_FIELDS), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(height))
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package info_sync
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	common2 "github.com/polynetwork/zion-example/modules/cross_chain_manager/common"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+)
+
+const (
+	// clique extra data is a vanity prefix followed by the signers on checkpoints and the seal
+	EXTRA_VANITY = 32
+	EXTRA_SEAL   = crypto.SignatureLength
+)
+
+// headerChain checks that root infos of a header synced chain extend the stored header chain,
+// tip is the last header of the stored chain or of the root infos checked before in the same call.
+// A call stops at the first header it leaves uncommitted, so the tip runs ahead of the stored chain
+// only for headers that may still be committed in order
+type headerChain struct {
+	chainID uint64
+	sealers []common.Address
+	tip     *types.Header
+}
+
+// newHeaderChain returns nil for chains whose root infos are opaque and only need a voter quorum
+func newHeaderChain(module *contract.ModuleContract, sideChain *side_chain_manager.SideChain) (*headerChain, error) {
+	if sideChain.Router != common2.ETH_COMMON_ROUTER {
+		return nil, nil
+	}
+	extraInfo, err := side_chain_manager.GetEthExtraInfo(module, sideChain.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("newHeaderChain, side_chain_manager.GetEthExtraInfo error: %v", err)
+	}
+	if !extraInfo.HeaderSync {
+		return nil, nil
+	}
+	chain := &headerChain{chainID: sideChain.ChainID, sealers: extraInfo.Sealers}
+	tip, ok, err := GetHeaderTip(module, sideChain.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("newHeaderChain, GetHeaderTip error: %v", err)
+	}
+	if !ok {
+		return chain, nil
+	}
+	info, err := GetRootInfo(module, sideChain.ChainID, tip)
+	if err != nil {
+		return nil, fmt.Errorf("newHeaderChain, GetRootInfo error: %v", err)
+	}
+	chain.tip = new(types.Header)
+	if err := rlp.DecodeBytes(info, chain.tip); err != nil {
+		return nil, fmt.Errorf("newHeaderChain, decode tip header error: %v", err)
+	}
+	return chain, nil
+}
+
+// verify checks a root info is a header extending the tip. The first header is the anchor of the chain and
+// trusted by the voter quorum, a header already accepted at its height is skipped
+func (this *headerChain) verify(module *contract.ModuleContract, rootInfo *RootInfo) (bool, error) {
+	header := new(types.Header)
+	if err := rlp.DecodeBytes(rootInfo.Info, header); err != nil {
+		return false, fmt.Errorf("decode header at height %d error: %v", rootInfo.Height, err)
+	}
+	if header.Number == nil || !header.Number.IsUint64() || header.Number.Uint64() != rootInfo.Height {
+		return false, fmt.Errorf("header number %v does not match height %d", header.Number, rootInfo.Height)
+	}
+	if this.tip != nil && header.Number.Cmp(this.tip.Number) <= 0 {
+		stored, err := GetRootInfo(module, this.chainID, rootInfo.Height)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(stored, rootInfo.Info) {
+			return true, nil
+		}
+		return false, fmt.Errorf("header at height %d does not extend the stored chain at %v", rootInfo.Height, this.tip.Number)
+	}
+	if this.tip != nil {
+		if err := verifyHeaderLink(this.tip, header); err != nil {
+			return false, fmt.Errorf("header at height %d, %v", rootInfo.Height, err)
+		}
+	}
+	if len(this.sealers) > 0 {
+		if err := verifyHeaderSeal(header, this.sealers); err != nil {
+			return false, fmt.Errorf("header at height %d, %v", rootInfo.Height, err)
+		}
+	}
+	this.tip = header
+	return false, nil
+}
+
+// verifyHeaderLink checks the fields of a header that follow from its parent
+func verifyHeaderLink(parent, header *types.Header) error {
+	if header.Number.Uint64() != parent.Number.Uint64()+1 {
+		return fmt.Errorf("number %v is not next to parent %v", header.Number, parent.Number)
+	}
+	if header.ParentHash != parent.Hash() {
+		return fmt.Errorf("parent hash %s mismatch, expect %s", header.ParentHash.Hex(), parent.Hash().Hex())
+	}
+	if header.Time <= parent.Time {
+		return fmt.Errorf("timestamp %d is not after parent %d", header.Time, parent.Time)
+	}
+	if header.GasUsed > header.GasLimit {
+		return fmt.Errorf("gas used %d exceeds gas limit %d", header.GasUsed, header.GasLimit)
+	}
+	parentGasLimit := parent.GasLimit
+	if parent.BaseFee == nil && header.BaseFee != nil {
+		// the gas target is kept at the london fork
+		parentGasLimit = parentGasLimit * params.DefaultElasticityMultiplier
+	}
+	if err := misc.VerifyGaslimit(parentGasLimit, header.GasLimit); err != nil {
+		return err
+	}
+	if header.Difficulty == nil || header.Difficulty.Sign() < 0 {
+		return fmt.Errorf("invalid difficulty")
+	}
+	// a proof of stake chain never returns to proof of work
+	if parent.Difficulty != nil && parent.Difficulty.Sign() == 0 && header.Difficulty.Sign() != 0 {
+		return fmt.Errorf("non zero difficulty %v after proof of stake", header.Difficulty)
+	}
+	return nil
+}
+
+// verifyHeaderSeal checks a clique header is sealed by one of the sealers
+func verifyHeaderSeal(header *types.Header, sealers []common.Address) error {
+	if len(header.Extra) < EXTRA_VANITY+EXTRA_SEAL {
+		return fmt.Errorf("extra data of %d bytes has no seal", len(header.Extra))
+	}
+	if header.Difficulty.Cmp(common.Big1) != 0 && header.Difficulty.Cmp(common.Big2) != 0 {
+		return fmt.Errorf("invalid clique difficulty %v", header.Difficulty)
+	}
+	pub, err := crypto.Ecrecover(clique.SealHash(header).Bytes(), header.Extra[len(header.Extra)-EXTRA_SEAL:])
+	if err != nil {
+		return fmt.Errorf("recover seal error: %v", err)
+	}
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pub[1:])[12:])
+	for _, v := range sealers {
+		if v == signer {
+			return nil
+		}
+	}
+	return fmt.Errorf("sealer %s is not authorized", signer.Hex())
+}
@@ -0,0 +1,439 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package info_sync
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/cfg"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/polynetwork/zion-example/modules/side_chain_manager"
+)
+
+const (
+	// root infos deleted per block by the prune handler over all chains
+	PRUNE_BATCH_SIZE = 256
+	// checkpoints a chain can pin
+	MAX_PINNED_HEIGHTS = 100
+)
+
+// ErrRootInfoPruned is returned by GetRootInfo for a height removed by the retention window,
+// a proof has to be made against a newer height
+var ErrRootInfoPruned = errors.New("root info is pruned")
+
+// SetRetention changes the retention window of a chain once a signer quorum votes for it, a zero window keeps all root infos
+func SetRetention(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &SetRetentionParam{}
+	if err := contract.UnpackMethod(ABI, MethodSetRetention, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if err := checkSideChain(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("SetRetention, %v", err)
+	}
+	retention, err := GetRetentionObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetRetention, GetRetentionObj error: %v", err)
+	}
+	blob, err := rlp.EncodeToBytes([]interface{}{params.ChainID, params.Window, retention.Revision})
+	if err != nil {
+		return nil, fmt.Errorf("SetRetention, rlp.EncodeToBytes retention error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSetRetention, blob, s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetRetention, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, MethodSetRetention, true)
+	}
+
+	retention.Window = params.Window
+	retention.Revision = retention.Revision + 1
+	if err := putRetention(s, params.ChainID, retention); err != nil {
+		return nil, fmt.Errorf("SetRetention, putRetention error: %v", err)
+	}
+	if params.Window == 0 {
+		err = removeRetentionIndex(s, params.ChainID)
+	} else {
+		err = addRetentionIndex(s, params.ChainID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("SetRetention, update retention index error: %v", err)
+	}
+	if err := s.AddNotify(ABI, []string{RETENTION_UPDATED}, params.ChainID, params.Window); err != nil {
+		return nil, fmt.Errorf("SetRetention, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodSetRetention, true)
+}
+
+// PinRootInfo keeps the root info of a checkpoint height out of pruning once a signer quorum votes for it.
+// Unpinning a height below the pruned height deletes its root info at once
+func PinRootInfo(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &PinRootInfoParam{}
+	if err := contract.UnpackMethod(ABI, MethodPinRootInfo, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if err := checkSideChain(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("PinRootInfo, %v", err)
+	}
+	pinned, err := getPinnedHeights(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("PinRootInfo, getPinnedHeights error: %v", err)
+	}
+	// votes arriving after the quorum find the height pinned already
+	if containsHeight(pinned, params.Height) == params.Pinned {
+		return contract.PackOutputs(ABI, MethodPinRootInfo, true)
+	}
+	if params.Pinned {
+		if len(pinned) >= MAX_PINNED_HEIGHTS {
+			return nil, fmt.Errorf("PinRootInfo, at most %d heights can be pinned", MAX_PINNED_HEIGHTS)
+		}
+		pruned, err := isPruned(s, params.ChainID, params.Height)
+		if err != nil {
+			return nil, fmt.Errorf("PinRootInfo, isPruned error: %v", err)
+		}
+		if pruned {
+			return nil, fmt.Errorf("PinRootInfo, height %d: %w", params.Height, ErrRootInfoPruned)
+		}
+	}
+	retention, err := GetRetentionObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("PinRootInfo, GetRetentionObj error: %v", err)
+	}
+	blob, err := rlp.EncodeToBytes([]interface{}{params.ChainID, params.Height, params.Pinned, retention.Revision})
+	if err != nil {
+		return nil, fmt.Errorf("PinRootInfo, rlp.EncodeToBytes pin error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodPinRootInfo, blob, s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("PinRootInfo, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, MethodPinRootInfo, true)
+	}
+
+	retention.Revision = retention.Revision + 1
+	if err := putRetention(s, params.ChainID, retention); err != nil {
+		return nil, fmt.Errorf("PinRootInfo, putRetention error: %v", err)
+	}
+	if params.Pinned {
+		pinned = append(pinned, params.Height)
+		sort.Slice(pinned, func(i, j int) bool { return pinned[i] < pinned[j] })
+	} else {
+		for i, v := range pinned {
+			if v == params.Height {
+				pinned = append(pinned[:i], pinned[i+1:]...)
+				break
+			}
+		}
+		prunedHeight, err := getPrunedHeight(s, params.ChainID)
+		if err != nil {
+			return nil, fmt.Errorf("PinRootInfo, getPrunedHeight error: %v", err)
+		}
+		if params.Height < prunedHeight {
+			deleteRootInfo(s, params.ChainID, params.Height)
+		}
+	}
+	if err := putPinnedHeights(s, params.ChainID, pinned); err != nil {
+		return nil, fmt.Errorf("PinRootInfo, putPinnedHeights error: %v", err)
+	}
+	if err := s.AddNotify(ABI, []string{ROOT_INFO_PINNED}, params.ChainID, params.Height, params.Pinned); err != nil {
+		return nil, fmt.Errorf("PinRootInfo, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodPinRootInfo, true)
+}
+
+func GetRetention(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetRetentionParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetRetention, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	retention, err := GetRetentionObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRetention, GetRetentionObj error: %v", err)
+	}
+	prunedHeight, err := getPrunedHeight(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRetention, getPrunedHeight error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetRetention, retention.Window, prunedHeight)
+}
+
+// PruneRootInfo is the end block handler of info sync, it deletes root infos older than the retention
+// window of each chain in sync order, at most PRUNE_BATCH_SIZE per block, skipping pinned heights.
+// Each block starts from the chain after the one the previous block started from
+func PruneRootInfo(s *contract.ModuleContract) error {
+	index, err := getRetentionIndex(s)
+	if err != nil {
+		return fmt.Errorf("PruneRootInfo, getRetentionIndex error: %v", err)
+	}
+	if len(index) == 0 {
+		return nil
+	}
+	start, err := getPruneCursor(s)
+	if err != nil {
+		return fmt.Errorf("PruneRootInfo, getPruneCursor error: %v", err)
+	}
+	first := sort.Search(len(index), func(i int) bool { return index[i] >= start }) % len(index)
+	if len(index) > 1 {
+		if err := putPruneCursor(s, index[(first+1)%len(index)]); err != nil {
+			return fmt.Errorf("PruneRootInfo, putPruneCursor error: %v", err)
+		}
+	}
+	budget := PRUNE_BATCH_SIZE
+	for i := 0; i < len(index) && budget > 0; i++ {
+		chainID := index[(first+i)%len(index)]
+		retention, err := GetRetentionObj(s, chainID)
+		if err != nil {
+			return fmt.Errorf("PruneRootInfo, GetRetentionObj error: %v", err)
+		}
+		current, err := GetCurrentHeight(s, chainID)
+		if err != nil {
+			return fmt.Errorf("PruneRootInfo, GetCurrentHeight error: %v", err)
+		}
+		if retention.Window == 0 || current <= retention.Window {
+			continue
+		}
+		target := current - retention.Window
+		prunedHeight, err := getPrunedHeight(s, chainID)
+		if err != nil {
+			return fmt.Errorf("PruneRootInfo, getPrunedHeight error: %v", err)
+		}
+		if prunedHeight >= target {
+			continue
+		}
+		pinned, err := getPinnedHeights(s, chainID)
+		if err != nil {
+			return fmt.Errorf("PruneRootInfo, getPinnedHeights error: %v", err)
+		}
+		used, next, done, err := dropStoredHeights(s, chainID, target-1, pinned, budget)
+		if err != nil {
+			return fmt.Errorf("PruneRootInfo, %v", err)
+		}
+		budget -= used
+		if done {
+			next = target
+		}
+		if next <= prunedHeight {
+			continue
+		}
+		if err := putPrunedHeight(s, chainID, next); err != nil {
+			return fmt.Errorf("PruneRootInfo, putPrunedHeight error: %v", err)
+		}
+		if err := s.AddNotify(ABI, []string{ROOT_INFO_PRUNED}, chainID, next); err != nil {
+			return fmt.Errorf("PruneRootInfo, AddNotify error: %v", err)
+		}
+	}
+	return nil
+}
+
+func checkSideChain(module *contract.ModuleContract, chainID uint64) error {
+	sideChain, err := side_chain_manager.GetSideChainObject(module, chainID)
+	if err != nil {
+		return fmt.Errorf("side_chain_manager.GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return fmt.Errorf("side chain %d is not registered", chainID)
+	}
+	return nil
+}
+
+// isPruned tells whether the root info of a height was removed or would not be kept by the retention window
+func isPruned(module *contract.ModuleContract, chainID uint64, height uint64) (bool, error) {
+	prunedHeight, err := getPrunedHeight(module, chainID)
+	if err != nil || height >= prunedHeight {
+		return false, err
+	}
+	pinned, err := getPinnedHeights(module, chainID)
+	if err != nil {
+		return false, err
+	}
+	return !containsHeight(pinned, height), nil
+}
+
+func containsHeight(heights []uint64, height uint64) bool {
+	for _, v := range heights {
+		if v == height {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteRootInfo(module *contract.ModuleContract, chainID uint64, height uint64) {
+	module.GetCacheDB().Delete(rootInfoKey(chainID, height))
+	module.GetCacheDB().Delete(rootInfoVotesKey(chainID, height))
+	module.GetCacheDB().Delete(headerFieldsKey(chainID, height))
+	module.GetCacheDB().Delete(replenishHeightKey(chainID, height))
+}
+
+func retentionKey(chainID uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(RETENTION), utils.GetUint64Bytes(chainID))
+}
+
+// GetRetentionObj returns the retention of a chain, root infos are kept forever before it is set
+func GetRetentionObj(module *contract.ModuleContract, chainID uint64) (*Retention, error) {
+	store, err := module.GetCacheDB().Get(retentionKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetRetentionObj, module.GetCacheDB().Get error: %v", err)
+	}
+	retention := new(Retention)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, retention); err != nil {
+			return nil, fmt.Errorf("GetRetentionObj, deserialize retention error: %v", err)
+		}
+	}
+	return retention, nil
+}
+
+func putRetention(module *contract.ModuleContract, chainID uint64, retention *Retention) error {
+	blob, err := rlp.EncodeToBytes(retention)
+	if err != nil {
+		return fmt.Errorf("putRetention, rlp.EncodeToBytes retention error: %v", err)
+	}
+	return module.GetCacheDB().Put(retentionKey(chainID), blob)
+}
+
+func prunedHeightKey(chainID uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(PRUNED_HEIGHT), utils.GetUint64Bytes(chainID))
+}
+
+// getPrunedHeight returns the height below which root infos are pruned unless pinned
+func getPrunedHeight(module *contract.ModuleContract, chainID uint64) (uint64, error) {
+	r, err := module.GetCacheDB().Get(prunedHeightKey(chainID))
+	if err != nil {
+		return 0, fmt.Errorf("getPrunedHeight, module.GetCacheDB().Get error: %v", err)
+	}
+	return decodeHeight(r), nil
+}
+
+func putPrunedHeight(module *contract.ModuleContract, chainID uint64, height uint64) error {
+	return module.GetCacheDB().Put(prunedHeightKey(chainID), encodeHeight(height))
+}
+
+func pinnedHeightsKey(chainID uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(PINNED_HEIGHTS), utils.GetUint64Bytes(chainID))
+}
+
+func getPinnedHeights(module *contract.ModuleContract, chainID uint64) ([]uint64, error) {
+	store, err := module.GetCacheDB().Get(pinnedHeightsKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getPinnedHeights, module.GetCacheDB().Get error: %v", err)
+	}
+	heights := make([]uint64, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &heights); err != nil {
+			return nil, fmt.Errorf("getPinnedHeights, deserialize pinned heights error: %v", err)
+		}
+	}
+	return heights, nil
+}
+
+func putPinnedHeights(module *contract.ModuleContract, chainID uint64, heights []uint64) error {
+	if len(heights) == 0 {
+		module.GetCacheDB().Delete(pinnedHeightsKey(chainID))
+		return nil
+	}
+	blob, err := rlp.EncodeToBytes(heights)
+	if err != nil {
+		return fmt.Errorf("putPinnedHeights, rlp.EncodeToBytes pinned heights error: %v", err)
+	}
+	return module.GetCacheDB().Put(pinnedHeightsKey(chainID), blob)
+}
+
+func pruneCursorKey() []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(PRUNE_CURSOR))
+}
+
+// getPruneCursor returns the chain the next prune starts from, a chain that left the retention index passes its turn on
+func getPruneCursor(module *contract.ModuleContract) (uint64, error) {
+	r, err := module.GetCacheDB().Get(pruneCursorKey())
+	if err != nil {
+		return 0, fmt.Errorf("getPruneCursor, module.GetCacheDB().Get error: %v", err)
+	}
+	if r == nil {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(r), nil
+}
+
+func putPruneCursor(module *contract.ModuleContract, chainID uint64) error {
+	return module.GetCacheDB().Put(pruneCursorKey(), utils.GetUint64Bytes(chainID))
+}
+
+func retentionIndexKey() []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(RETENTION_INDEX))
+}
+
+// getRetentionIndex returns the chains with a retention window in ascending order
+func getRetentionIndex(module *contract.ModuleContract) ([]uint64, error) {
+	store, err := module.GetCacheDB().Get(retentionIndexKey())
+	if err != nil {
+		return nil, fmt.Errorf("getRetentionIndex, module.GetCacheDB().Get error: %v", err)
+	}
+	index := make([]uint64, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &index); err != nil {
+			return nil, fmt.Errorf("getRetentionIndex, deserialize retention index error: %v", err)
+		}
+	}
+	return index, nil
+}
+
+func putRetentionIndex(module *contract.ModuleContract, index []uint64) error {
+	blob, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return fmt.Errorf("putRetentionIndex, rlp.EncodeToBytes retention index error: %v", err)
+	}
+	return module.GetCacheDB().Put(retentionIndexKey(), blob)
+}
+
+func addRetentionIndex(module *contract.ModuleContract, chainID uint64) error {
+	index, err := getRetentionIndex(module)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(index), func(i int) bool { return index[i] >= chainID })
+	if i < len(index) && index[i] == chainID {
+		return nil
+	}
+	index = append(index, 0)
+	copy(index[i+1:], index[i:])
+	index[i] = chainID
+	return putRetentionIndex(module, index)
+}
+
+func removeRetentionIndex(module *contract.ModuleContract, chainID uint64) error {
+	index, err := getRetentionIndex(module)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(index), func(i int) bool { return index[i] >= chainID })
+	if i == len(index) || index[i] != chainID {
+		return nil
+	}
+	return putRetentionIndex(module, append(index[:i], index[i+1:]...))
+}
@@ -25,7 +25,7 @@ import (
 
 type RootInfoUnique struct {
 	ChainID uint64
-	Height  uint32
+	Height  uint64
 	Info    []byte
 }
 
@@ -36,7 +36,7 @@ func (d *RootInfoUnique) EncodeRLP(w io.Writer) error {
 func (d *RootInfoUnique) DecodeRLP(s *rlp.Stream) error {
 	var data struct {
 		ChainID uint64
-		Height  uint32
+		Height  uint64
 		Info    []byte
 	}
 
@@ -49,23 +49,46 @@ func (d *RootInfoUnique) DecodeRLP(s *rlp.Stream) error {
 }
 
 type RootInfo struct {
-	Height uint32
+	Height uint64
 	Info   []byte
+	// the voting round of the signer, a vote of a height is only replaced by one signed for a later round.
+	// Root infos of round 0 are encoded as before
+	Round uint64
 }
 
 func (m *RootInfo) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{m.Height, m.Info})
+	if m.Round == 0 {
+		return rlp.Encode(w, []interface{}{m.Height, m.Info})
+	}
+	return rlp.Encode(w, []interface{}{m.Height, m.Info, m.Round})
 }
 func (m *RootInfo) DecodeRLP(s *rlp.Stream) error {
 	var data struct {
-		Height uint32
+		Height uint64
 		Info   []byte
+		Round  uint64 `rlp:"optional"`
 	}
 
 	if err := s.Decode(&data); err != nil {
 		return err
 	}
 
-	m.Height, m.Info = data.Height, data.Info
+	m.Height, m.Info, m.Round = data.Height, data.Info, data.Round
 	return nil
 }
+
+// StoredHeights is the queue of the heights whose root infos are stored in the order they were synced, from
+// Head to Tail. Heights synced before the queue was kept are the range from LegacyFrom to LegacyTo
+type StoredHeights struct {
+	Head       uint64
+	Tail       uint64
+	Legacy     bool
+	LegacyFrom uint64
+	LegacyTo   uint64
+}
+
+// Retention is the number of heights below the current height whose root infos are kept, 0 keeps all
+type Retention struct {
+	Window   uint64
+	Revision uint64
+}
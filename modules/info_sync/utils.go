@@ -19,27 +19,64 @@
 package info_sync
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
 )
 
 const (
 	//key prefix
-	ROOT_INFO            = "rootInfo"
-	CURRENT_HEIGHT       = "currentHeight"
-	SYNC_ROOT_INFO_EVENT = "SyncRootInfoEvent"
-	REPLENISH_EVENT      = "ReplenishEvent"
+	ROOT_INFO               = "rootInfo"
+	ROOT_INFO_64            = "rootInfo64"
+	CURRENT_HEIGHT          = "currentHeight"
+	LOWEST_HEIGHT           = "lowestHeight"
+	HEADER_TIP              = "headerTip"
+	HEADER_FIELDS           = "headerFields"
+	ROOT_INFO_VOTES         = "rootInfoVotes"
+	ROOT_INFO_VOTES_64      = "rootInfoVotes64"
+	RETENTION               = "retention"
+	RETENTION_INDEX         = "retentionIndex"
+	PRUNE_CURSOR            = "pruneCursor"
+	PRUNED_HEIGHT           = "prunedHeight"
+	PINNED_HEIGHTS          = "pinnedHeights"
+	REPLENISH_HEIGHT        = "replenishHeight"
+	STORED_HEIGHTS          = "storedHeights"
+	STORED_HEIGHT           = "storedHeight"
+	SYNC_ROOT_INFO_EVENT    = "SyncRootInfoEvent"
+	SYNC_ROOT_INFO_64_EVENT = "SyncRootInfo64Event"
+	REPLENISH_EVENT         = "ReplenishEvent"
+	REPLENISH_64_EVENT      = "Replenish64Event"
+	ROOT_INFO_CONFLICT      = "RootInfoConflict"
+	RETENTION_UPDATED       = "RetentionUpdated"
+	ROOT_INFO_PINNED        = "RootInfoPinned"
+	ROOT_INFO_PRUNED        = "RootInfoPruned"
 )
 
-func PutRootInfo(module *contract.ModuleContract, chainID uint64, height uint32, info []byte) error {
+func PutRootInfo(module *contract.ModuleContract, chainID uint64, height uint64, info []byte) error {
 	contractAddr := cfg.InfoSyncContractAddress
 	chainIDBytes := utils.GetUint64Bytes(chainID)
-	heightBytes := utils.GetUint32Bytes(height)
+	heightBytes := encodeHeight(height)
 
-	err := module.GetCacheDB().Put(utils.ConcatKey(contractAddr, []byte(ROOT_INFO), chainIDBytes, heightBytes), info)
+	// a finalised root info is never overwritten
+	stored, err := GetRootInfo(module, chainID, height)
+	if err != nil {
+		return fmt.Errorf("PutRootInfo, GetRootInfo error: %v", err)
+	}
+	if stored != nil && !bytes.Equal(stored, info) {
+		return fmt.Errorf("PutRootInfo, root info of height %d is finalised", height)
+	}
+	if stored == nil {
+		if err := pushStoredHeight(module, chainID, height); err != nil {
+			return fmt.Errorf("PutRootInfo, pushStoredHeight error: %v", err)
+		}
+	}
+	err = module.GetCacheDB().Put(rootInfoKey(chainID, height), info)
 	if err != nil {
 		return err
 	}
@@ -53,6 +90,22 @@ func PutRootInfo(module *contract.ModuleContract, chainID uint64, height uint32,
 			return err
 		}
 	}
+	lowestKey := utils.ConcatKey(contractAddr, []byte(LOWEST_HEIGHT), chainIDBytes)
+	lowest, err := module.GetCacheDB().Get(lowestKey)
+	if err != nil {
+		return fmt.Errorf("PutRootInfo, get lowest height error: %v", err)
+	}
+	if lowest == nil || height < decodeHeight(lowest) {
+		err := module.GetCacheDB().Put(lowestKey, heightBytes)
+		if err != nil {
+			return err
+		}
+	}
+	if err := indexHeaderFields(module, chainID, height, info); err != nil {
+		return fmt.Errorf("PutRootInfo, %v", err)
+	}
+	// a synced height is never requested again
+	module.GetCacheDB().Delete(replenishHeightKey(chainID, height))
 	err = NotifyPutRootInfo(module, chainID, height)
 	if err != nil {
 		return fmt.Errorf("PutRootInfo, NotifyPutRootInfo error: %v", err)
@@ -60,19 +113,49 @@ func PutRootInfo(module *contract.ModuleContract, chainID uint64, height uint32,
 	return nil
 }
 
-func GetRootInfo(module *contract.ModuleContract, chainID uint64, height uint32) ([]byte, error) {
-	contractAddr := cfg.InfoSyncContractAddress
-	chainIDBytes := utils.GetUint64Bytes(chainID)
-	heightBytes := utils.GetUint32Bytes(height)
-
-	r, err := module.GetCacheDB().Get(utils.ConcatKey(contractAddr, []byte(ROOT_INFO), chainIDBytes, heightBytes))
+// GetRootInfo returns the root info of a height, nil if it is not synced and ErrRootInfoPruned if it is pruned
+func GetRootInfo(module *contract.ModuleContract, chainID uint64, height uint64) ([]byte, error) {
+	r, err := module.GetCacheDB().Get(rootInfoKey(chainID, height))
 	if err != nil {
 		return nil, fmt.Errorf("GetRootInfo, module.GetCacheDB().Get error: %v", err)
 	}
+	if r == nil {
+		pruned, err := isPruned(module, chainID, height)
+		if err != nil {
+			return nil, fmt.Errorf("GetRootInfo, isPruned error: %v", err)
+		}
+		if pruned {
+			return nil, fmt.Errorf("GetRootInfo, height %d: %w", height, ErrRootInfoPruned)
+		}
+	}
 	return r, nil
 }
 
-func GetCurrentHeight(module *contract.ModuleContract, chainID uint64) (uint32, error) {
+// rootInfoKey keeps the uint32 key of heights that fit in it, so root infos stored before heights were
+// widened stay in place and a chain moves to the uint64 keys once it passes math.MaxUint32
+func rootInfoKey(chainID uint64, height uint64) []byte {
+	if height > math.MaxUint32 {
+		return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(ROOT_INFO_64), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(height))
+	}
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(ROOT_INFO), utils.GetUint64Bytes(chainID), utils.GetUint32Bytes(uint32(height)))
+}
+
+// encodeHeight stores heights that fit in uint32 in 4 bytes as they were stored before heights were widened
+func encodeHeight(height uint64) []byte {
+	if height > math.MaxUint32 {
+		return utils.GetUint64Bytes(height)
+	}
+	return utils.GetUint32Bytes(uint32(height))
+}
+
+func decodeHeight(b []byte) uint64 {
+	if len(b) == 8 {
+		return utils.GetBytesUint64(b)
+	}
+	return uint64(utils.GetBytesUint32(b))
+}
+
+func GetCurrentHeight(module *contract.ModuleContract, chainID uint64) (uint64, error) {
 	contractAddr := cfg.InfoSyncContractAddress
 	chainIDBytes := utils.GetUint64Bytes(chainID)
 
@@ -80,17 +163,287 @@ func GetCurrentHeight(module *contract.ModuleContract, chainID uint64) (uint32,
 	if err != nil {
 		return 0, fmt.Errorf("GetCurrentHeight, module.GetCacheDB().Get error: %v", err)
 	}
-	return utils.GetBytesUint32(r), nil
+	return decodeHeight(r), nil
 }
 
-func NotifyPutRootInfo(module *contract.ModuleContract, chainID uint64, height uint32) error {
-	err := module.AddNotify(ABI, []string{SYNC_ROOT_INFO_EVENT}, chainID, height, module.ContractRef().BlockHeight())
+// scanRootInfos reads the root infos of at most MAX_QUERY_RANGE heights from from to to, it returns
+// the synced heights with their infos and the heights that are neither synced nor pruned
+func scanRootInfos(module *contract.ModuleContract, chainID uint64, from, to uint64) ([]uint64, [][]byte, []uint64, error) {
+	if from > to {
+		return nil, nil, nil, fmt.Errorf("scanRootInfos, from %d is greater than to %d", from, to)
+	}
+	if to-from >= MAX_QUERY_RANGE {
+		return nil, nil, nil, fmt.Errorf("scanRootInfos, range of %d heights exceeds %d", to-from+1, MAX_QUERY_RANGE)
+	}
+	prunedHeight, err := getPrunedHeight(module, chainID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("scanRootInfos, getPrunedHeight error: %v", err)
+	}
+	pinned, err := getPinnedHeights(module, chainID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("scanRootInfos, getPinnedHeights error: %v", err)
+	}
+	heights, infos, missing := make([]uint64, 0), make([][]byte, 0), make([]uint64, 0)
+	for height := from; ; height++ {
+		info, err := module.GetCacheDB().Get(rootInfoKey(chainID, height))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("scanRootInfos, module.GetCacheDB().Get error: %v", err)
+		}
+		if info != nil {
+			heights, infos = append(heights, height), append(infos, info)
+		} else if height >= prunedHeight || containsHeight(pinned, height) {
+			missing = append(missing, height)
+		}
+		// to may be math.MaxUint64
+		if height == to {
+			break
+		}
+	}
+	return heights, infos, missing, nil
+}
+
+// GetLowestHeight returns the lowest synced height, 0 for chains synced before it was tracked
+func GetLowestHeight(module *contract.ModuleContract, chainID uint64) (uint64, error) {
+	contractAddr := cfg.InfoSyncContractAddress
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+
+	r, err := module.GetCacheDB().Get(utils.ConcatKey(contractAddr, []byte(LOWEST_HEIGHT), chainIDBytes))
+	if err != nil {
+		return 0, fmt.Errorf("GetLowestHeight, module.GetCacheDB().Get error: %v", err)
+	}
+	return decodeHeight(r), nil
+}
+
+// GetHeaderTip returns the height of the last header of a header synced chain, false before the anchor is synced
+func GetHeaderTip(module *contract.ModuleContract, chainID uint64) (uint64, bool, error) {
+	contractAddr := cfg.InfoSyncContractAddress
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+
+	r, err := module.GetCacheDB().Get(utils.ConcatKey(contractAddr, []byte(HEADER_TIP), chainIDBytes))
+	if err != nil {
+		return 0, false, fmt.Errorf("GetHeaderTip, module.GetCacheDB().Get error: %v", err)
+	}
+	return decodeHeight(r), r != nil, nil
+}
+
+func putHeaderTip(module *contract.ModuleContract, chainID uint64, height uint64) error {
+	contractAddr := cfg.InfoSyncContractAddress
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+
+	return module.GetCacheDB().Put(utils.ConcatKey(contractAddr, []byte(HEADER_TIP), chainIDBytes), encodeHeight(height))
+}
+
+// CleanRootInfo is the side chain cleaner of info sync, it deletes the root infos of a quit chain
+// in the order they were synced and then the height records
+func CleanRootInfo(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	contractAddr := cfg.InfoSyncContractAddress
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+
+	used, _, done, err := dropStoredHeights(module, chainID, math.MaxUint64, nil, limit)
+	if err != nil {
+		return 0, used, false, fmt.Errorf("CleanRootInfo, %v", err)
+	}
+	if !done {
+		return cursor, used, false, nil
+	}
+	// heights below the pruned height are gone already except the pinned ones
+	pinned, err := getPinnedHeights(module, chainID)
+	if err != nil {
+		return 0, used, false, fmt.Errorf("CleanRootInfo, getPinnedHeights error: %v", err)
+	}
+	for _, height := range pinned {
+		deleteRootInfo(module, chainID, height)
+	}
+	// the retention revision is kept since it guards consensus votes
+	retention, err := GetRetentionObj(module, chainID)
+	if err != nil {
+		return 0, used, false, fmt.Errorf("CleanRootInfo, GetRetentionObj error: %v", err)
+	}
+	if retention.Window != 0 {
+		retention.Window = 0
+		if err := putRetention(module, chainID, retention); err != nil {
+			return 0, used, false, fmt.Errorf("CleanRootInfo, putRetention error: %v", err)
+		}
+		if err := removeRetentionIndex(module, chainID); err != nil {
+			return 0, used, false, fmt.Errorf("CleanRootInfo, removeRetentionIndex error: %v", err)
+		}
+	}
+	module.GetCacheDB().Delete(utils.ConcatKey(contractAddr, []byte(CURRENT_HEIGHT), chainIDBytes))
+	module.GetCacheDB().Delete(utils.ConcatKey(contractAddr, []byte(LOWEST_HEIGHT), chainIDBytes))
+	module.GetCacheDB().Delete(utils.ConcatKey(contractAddr, []byte(HEADER_TIP), chainIDBytes))
+	module.GetCacheDB().Delete(prunedHeightKey(chainID))
+	module.GetCacheDB().Delete(pinnedHeightsKey(chainID))
+	module.GetCacheDB().Delete(storedHeightsKey(chainID))
+	return cursor, used + len(pinned) + 7, true, nil
+}
+
+func storedHeightsKey(chainID uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(STORED_HEIGHTS), utils.GetUint64Bytes(chainID))
+}
+
+func storedHeightKey(chainID uint64, seq uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(STORED_HEIGHT), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(seq))
+}
+
+// getStoredHeights returns the stored height queue of a chain, nil before a root info is put through it
+func getStoredHeights(module *contract.ModuleContract, chainID uint64) (*StoredHeights, error) {
+	store, err := module.GetCacheDB().Get(storedHeightsKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getStoredHeights, module.GetCacheDB().Get error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	stored := new(StoredHeights)
+	if err := rlp.DecodeBytes(store, stored); err != nil {
+		return nil, fmt.Errorf("getStoredHeights, deserialize stored heights error: %v", err)
+	}
+	return stored, nil
+}
+
+func putStoredHeights(module *contract.ModuleContract, chainID uint64, stored *StoredHeights) error {
+	blob, err := rlp.EncodeToBytes(stored)
+	if err != nil {
+		return fmt.Errorf("putStoredHeights, rlp.EncodeToBytes stored heights error: %v", err)
+	}
+	return module.GetCacheDB().Put(storedHeightsKey(chainID), blob)
+}
+
+// pushStoredHeight appends a newly synced height to the stored height queue, the heights a chain synced
+// before the queue was kept are tracked as the range from the lowest to the current height
+func pushStoredHeight(module *contract.ModuleContract, chainID uint64, height uint64) error {
+	stored, err := getStoredHeights(module, chainID)
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		stored = new(StoredHeights)
+		r, err := module.GetCacheDB().Get(utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(CURRENT_HEIGHT), utils.GetUint64Bytes(chainID)))
+		if err != nil {
+			return fmt.Errorf("pushStoredHeight, module.GetCacheDB().Get error: %v", err)
+		}
+		if r != nil {
+			lowest, err := GetLowestHeight(module, chainID)
+			if err != nil {
+				return err
+			}
+			prunedHeight, err := getPrunedHeight(module, chainID)
+			if err != nil {
+				return err
+			}
+			if lowest < prunedHeight {
+				lowest = prunedHeight
+			}
+			if lowest <= decodeHeight(r) {
+				stored.Legacy, stored.LegacyFrom, stored.LegacyTo = true, lowest, decodeHeight(r)
+			}
+		}
+	}
+	if err := module.GetCacheDB().Put(storedHeightKey(chainID, stored.Tail), utils.GetUint64Bytes(height)); err != nil {
+		return err
+	}
+	stored.Tail++
+	return putStoredHeights(module, chainID, stored)
+}
+
+// dropStoredHeights deletes at most limit root infos of heights up to last except the kept ones, the legacy
+// range first and then the queue in sync order until a height above last. It returns the budget used, the height it stopped at
+// and whether no height up to last is left to drop before the queue reaches a height above last
+func dropStoredHeights(module *contract.ModuleContract, chainID uint64, last uint64, keep []uint64, limit int) (int, uint64, bool, error) {
+	stored, err := getStoredHeights(module, chainID)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("dropStoredHeights, %v", err)
+	}
+	if stored == nil {
+		return 0, last, true, nil
+	}
+	used := 0
+	for stored.Legacy && stored.LegacyFrom <= last && used < limit {
+		if !containsHeight(keep, stored.LegacyFrom) {
+			deleteRootInfo(module, chainID, stored.LegacyFrom)
+		}
+		used++
+		if stored.LegacyFrom == stored.LegacyTo {
+			stored.Legacy = false
+		} else {
+			stored.LegacyFrom++
+		}
+	}
+	next, blocked := last, false
+	for stored.Head < stored.Tail {
+		key := storedHeightKey(chainID, stored.Head)
+		r, err := module.GetCacheDB().Get(key)
+		if err != nil {
+			return used, 0, false, fmt.Errorf("dropStoredHeights, module.GetCacheDB().Get error: %v", err)
+		}
+		height := utils.GetBytesUint64(r)
+		if height > last {
+			blocked = true
+			break
+		}
+		if used >= limit {
+			next = height
+			break
+		}
+		if !containsHeight(keep, height) {
+			deleteRootInfo(module, chainID, height)
+		}
+		module.GetCacheDB().Delete(key)
+		stored.Head++
+		used++
+	}
+	if err := putStoredHeights(module, chainID, stored); err != nil {
+		return used, 0, false, fmt.Errorf("dropStoredHeights, %v", err)
+	}
+	if stored.Legacy && stored.LegacyFrom <= last {
+		return used, stored.LegacyFrom, false, nil
+	}
+	done := blocked || stored.Head == stored.Tail
+	return used, next, done, nil
+}
+
+// NotifyPutRootInfo emits SyncRootInfoEvent for heights that fit in uint32 and SyncRootInfo64Event above
+func NotifyPutRootInfo(module *contract.ModuleContract, chainID uint64, height uint64) error {
+	var err error
+	if height > math.MaxUint32 {
+		err = module.AddNotify(ABI, []string{SYNC_ROOT_INFO_64_EVENT}, chainID, height, module.ContractRef().BlockHeight())
+	} else {
+		err = module.AddNotify(ABI, []string{SYNC_ROOT_INFO_EVENT}, chainID, uint32(height), module.ContractRef().BlockHeight())
+	}
 	if err != nil {
 		return fmt.Errorf("NotifyPutRootInfo failed: %v", err)
 	}
 	return nil
 }
 
+func NotifyRootInfoConflict(module *contract.ModuleContract, chainID uint64, height uint64, voter common.Address, infoHash, conflictHash common.Hash) error {
+	err := module.AddNotify(ABI, []string{ROOT_INFO_CONFLICT}, chainID, height, voter, infoHash, conflictHash)
+	if err != nil {
+		return fmt.Errorf("NotifyRootInfoConflict failed: %v", err)
+	}
+	return nil
+}
+
+func replenishHeightKey(chainID uint64, height uint64) []byte {
+	return utils.ConcatKey(cfg.InfoSyncContractAddress, []byte(REPLENISH_HEIGHT), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(height))
+}
+
+// getReplenishHeight returns the block a height was last requested to be replenished at
+func getReplenishHeight(module *contract.ModuleContract, chainID uint64, height uint64) (uint64, bool, error) {
+	r, err := module.GetCacheDB().Get(replenishHeightKey(chainID, height))
+	if err != nil {
+		return 0, false, fmt.Errorf("getReplenishHeight, module.GetCacheDB().Get error: %v", err)
+	}
+	if r == nil {
+		return 0, false, nil
+	}
+	return utils.GetBytesUint64(r), true, nil
+}
+
+func putReplenishHeight(module *contract.ModuleContract, chainID uint64, height uint64, blockHeight uint64) error {
+	return module.GetCacheDB().Put(replenishHeightKey(chainID, height), utils.GetUint64Bytes(blockHeight))
+}
+
 func NotifyReplenish(module *contract.ModuleContract, heights []uint32, chainId uint64) error {
 	err := module.AddNotify(ABI, []string{REPLENISH_EVENT}, heights, chainId)
 	if err != nil {
@@ -98,3 +451,11 @@ func NotifyReplenish(module *contract.ModuleContract, heights []uint32, chainId
 	}
 	return nil
 }
+
+func NotifyReplenish64(module *contract.ModuleContract, heights []uint64, chainId uint64) error {
+	err := module.AddNotify(ABI, []string{REPLENISH_64_EVENT}, heights, chainId)
+	if err != nil {
+		return fmt.Errorf("NotifyReplenish64 failed: %v", err)
+	}
+	return nil
+}
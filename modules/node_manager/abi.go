@@ -249,3 +249,23 @@ type GetStakeRewardsParam struct {
 func (m *GetStakeRewardsParam) Encode() ([]byte, error) {
 	return contract.PackMethodWithStruct(ABI, MethodGetStakeRewards, m)
 }
+
+type GetSignProgressParam struct {
+	Method    string
+	InputHash common.Hash
+}
+
+func (m *GetSignProgressParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetSignProgress, m)
+}
+
+type GetEvidenceParam struct {
+	Signer common.Address
+	Module common.Address
+	Kind   string
+	Height uint64
+}
+
+func (m *GetEvidenceParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetEvidence, m)
+}
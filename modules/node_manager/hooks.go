@@ -25,8 +25,44 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/log"
 )
 
+// EndBlockHook is executed by the end block system tx after rewards are allocated,
+// hooks run in the order they are registered and a hook returning an error is skipped
+type EndBlockHook func(s *contract.ModuleContract) error
+
+type endBlockHook struct {
+	name string
+	hook EndBlockHook
+}
+
+var endBlockHooks []endBlockHook
+
+// RegisterEndBlockHook registers a hook of other modules, registering the same name
+// again replaces the hook in place so module init stays idempotent
+func RegisterEndBlockHook(name string, hook EndBlockHook) {
+	for i, v := range endBlockHooks {
+		if v.name == name {
+			endBlockHooks[i].hook = hook
+			return
+		}
+	}
+	endBlockHooks = append(endBlockHooks, endBlockHook{name, hook})
+}
+
+// runEndBlockHooks runs every registered hook. A failing hook must not halt the chain, so its writes are
+// reverted, the error is logged and the other hooks still run
+func runEndBlockHooks(s *contract.ModuleContract) {
+	for _, v := range endBlockHooks {
+		snapshot := s.StateDB().Snapshot()
+		if err := v.hook(s); err != nil {
+			s.StateDB().RevertToSnapshot(snapshot)
+			log.Error("runEndBlockHooks, hook failed", "name", v.name, "err", err)
+		}
+	}
+}
+
 func AfterValidatorCreated(s *contract.ModuleContract, validator *Validator) error {
 	// set initial historical rewards (period 0) with reference count of 1
 	err := setValidatorSnapshotRewards(s, validator.ConsensusAddress, 0, &ValidatorSnapshotRewards{NewDecFromBigInt(new(big.Int)), 1})
@@ -86,6 +86,8 @@ func RegisterNodeManagerContract(s *contract.ModuleContract) {
 	s.Register(MethodGetTotalPool, GetTotalPool)
 	s.Register(MethodGetOutstandingRewards, GetOutstandingRewards)
 	s.Register(MethodGetStakeRewards, GetStakeRewards)
+	s.Register(MethodGetSignProgress, GetSignProgress)
+	s.Register(MethodGetEvidence, GetEvidence)
 }
 
 func CreateValidator(s *contract.ModuleContract) ([]byte, error) {
@@ -848,6 +850,7 @@ func EndBlock(s *contract.ModuleContract) ([]byte, error) {
 		return nil, fmt.Errorf("EndBlock, setOutstandingRewards error: %v", err)
 	}
 
+	runEndBlockHooks(s)
 	return nil, nil
 }
 
@@ -1167,3 +1170,60 @@ func GetStakeRewards(s *contract.ModuleContract) ([]byte, error) {
 
 	return contract.PackOutputs(ABI, MethodGetStakeRewards, enc)
 }
+
+// GetSignProgress returns the signers so far of the consensus sign of a method and input hash and the
+// quorum of its role in the current epoch, signs stored before they were indexed can not be found
+func GetSignProgress(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	params := &GetSignProgressParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetSignProgress, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetSignProgress, unpack params error: %v", err)
+	}
+
+	index, err := getSignIndex(s, params.Method, params.InputHash)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignProgress, getSignIndex error: %v", err)
+	}
+	signers, err := getSigners(s, index.Hash)
+	if err != nil {
+		if err != ErrEof {
+			return nil, fmt.Errorf("GetSignProgress, getSigners error: %v", err)
+		}
+		signers = make([]common.Address, 0)
+	}
+	epoch, err := GetCurrentEpochInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignProgress, GetCurrentEpochInfoImpl error: %v", err)
+	}
+	progress := &SignProgress{
+		Signers: signers,
+		Quorum:  uint64(QuorumSize(epoch, SignerName(index.Role))),
+	}
+	enc, err := rlp.EncodeToBytes(progress)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignProgress, serialize sign progress error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetSignProgress, enc)
+}
+
+// GetEvidence returns the evidence reported of a signer for a module, kind and height, empty bytes if there is none
+func GetEvidence(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	params := &GetEvidenceParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetEvidence, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetEvidence, unpack params error: %v", err)
+	}
+	evidence, err := GetEvidenceImpl(s, params.Signer, params.Module, params.Kind, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetEvidence, %v", err)
+	}
+	enc := make([]byte, 0)
+	if evidence != nil {
+		if enc, err = rlp.EncodeToBytes(evidence); err != nil {
+			return nil, fmt.Errorf("GetEvidence, serialize evidence error: %v", err)
+		}
+	}
+	return contract.PackOutputs(ABI, MethodGetEvidence, enc)
+}
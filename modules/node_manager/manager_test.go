@@ -1467,3 +1467,37 @@ func TestUnStakeParam(t *testing.T) {
 		})
 	}
 }
+
+func TestEndBlockHookFailure(t *testing.T) {
+	Init()
+	hooks := endBlockHooks
+	defer func() { endBlockHooks = hooks }()
+	endBlockHooks = nil
+
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, common.EmptyAddress, common.EmptyAddress, big.NewInt(1), common.Hash{}, 0, nil))
+	key := func(name string) []byte {
+		return utils.ConcatKey(this, []byte("test_hook"), []byte(name))
+	}
+	write := func(name string, fail bool) EndBlockHook {
+		return func(s *contract.ModuleContract) error {
+			if err := s.GetCacheDB().Put(key(name), []byte{1}); err != nil {
+				return err
+			}
+			if fail {
+				return fmt.Errorf("hook %s failed", name)
+			}
+			return nil
+		}
+	}
+	RegisterEndBlockHook("first", write("first", false))
+	RegisterEndBlockHook("broken", write("broken", true))
+	RegisterEndBlockHook("last", write("last", false))
+
+	// a failing hook is reverted and the hooks after it still run
+	runEndBlockHooks(c)
+	for name, written := range map[string]bool{"first": true, "broken": false, "last": true} {
+		value, err := c.GetCacheDB().Get(key(name))
+		assert.Nil(t, err)
+		assert.Equal(t, written, len(value) > 0, name)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/contract/utils"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -55,6 +56,8 @@ const (
 	SKP_SIGN                          = "st_sign"
 	SKP_SIGNER                        = "st_signer"
 	SKP_COMMUNITY_INFO                = "st_community_info"
+	SKP_EVIDENCE                      = "st_evidence"
+	SKP_SIGN_INDEX                    = "st_sign_index"
 )
 
 func setAccumulatedCommission(s *contract.ModuleContract, consensusAddr common.Address, accumulatedCommission *AccumulatedCommission) error {
@@ -825,6 +828,39 @@ func GetCommunityInfoImpl(s *contract.ModuleContract) (*CommunityInfo, error) {
 	return communityInfo, nil
 }
 
+// ReportEvidence records misbehaviour of a signer reported by another module, so that it can be punished. Evidence
+// is kept once per module, kind and height, a later report of the same misbehaviour is dropped
+func ReportEvidence(s *contract.ModuleContract, signer common.Address, evidence *Evidence) error {
+	exist, err := GetEvidenceImpl(s, signer, evidence.Module, evidence.Kind, evidence.Height)
+	if err != nil {
+		return fmt.Errorf("ReportEvidence, GetEvidenceImpl error: %v", err)
+	}
+	if exist != nil {
+		return nil
+	}
+	store, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		return fmt.Errorf("ReportEvidence, serialize evidence error: %v", err)
+	}
+	return set(s, evidenceKey(signer, evidence.Module, evidence.Kind, evidence.Height), store)
+}
+
+// GetEvidenceImpl returns nil if no evidence of the signer is reported for the module, kind and height
+func GetEvidenceImpl(s *contract.ModuleContract, signer, module common.Address, kind string, height uint64) (*Evidence, error) {
+	store, err := get(s, evidenceKey(signer, module, kind, height))
+	if err == ErrEof {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetEvidenceImpl, get store error: %v", err)
+	}
+	evidence := new(Evidence)
+	if err := rlp.DecodeBytes(store, evidence); err != nil {
+		return nil, fmt.Errorf("GetEvidenceImpl, deserialize evidence error: %v", err)
+	}
+	return evidence, nil
+}
+
 // ====================================================================
 //
 // `consensus sign` storage
@@ -890,6 +926,31 @@ func storeSigner(s *contract.ModuleContract, hash common.Hash, signer common.Add
 	return nil
 }
 
+func removeSigner(s *contract.ModuleContract, hash common.Hash, signer common.Address) error {
+	data, err := getSigners(s, hash)
+	if err != nil {
+		if err.Error() == ErrEof.Error() {
+			return nil
+		}
+		return err
+	}
+	list := &AddressList{List: make([]common.Address, 0, len(data))}
+	for _, v := range data {
+		if v != signer {
+			list.List = append(list.List, v)
+		}
+	}
+	if len(list.List) == len(data) {
+		return nil
+	}
+
+	value, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		return err
+	}
+	return set(s, signerKey(hash), value)
+}
+
 func findSigner(s *contract.ModuleContract, hash common.Hash, signer common.Address) bool {
 	list, err := getSigners(s, hash)
 	if err != nil {
@@ -934,6 +995,26 @@ func clearSigner(s *contract.ModuleContract, hash common.Hash) error {
 	return nil
 }
 
+func storeSignIndex(s *contract.ModuleContract, method string, input []byte, index *SignIndex) error {
+	value, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return err
+	}
+	return set(s, signIndexKey(method, crypto.Keccak256Hash(input)), value)
+}
+
+func getSignIndex(s *contract.ModuleContract, method string, inputHash common.Hash) (*SignIndex, error) {
+	value, err := get(s, signIndexKey(method, inputHash))
+	if err != nil {
+		return nil, err
+	}
+	index := new(SignIndex)
+	if err := rlp.DecodeBytes(value, index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
 // ====================================================================
 //
 // storage basic operations
@@ -1060,3 +1141,11 @@ func signerKey(hash common.Hash) []byte {
 func communityInfoKey() []byte {
 	return utils.ConcatKey(this, []byte(SKP_COMMUNITY_INFO))
 }
+
+func signIndexKey(method string, inputHash common.Hash) []byte {
+	return utils.ConcatKey(this, []byte(SKP_SIGN_INDEX), utils.RLPHash([]interface{}{method, inputHash}).Bytes())
+}
+
+func evidenceKey(signer, module common.Address, kind string, height uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_EVIDENCE), signer[:], module[:], utils.RLPHash([]interface{}{kind, height}).Bytes())
+}
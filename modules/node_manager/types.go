@@ -334,6 +334,46 @@ func (m *ConsensusSign) Hash() common.Hash {
 	return v
 }
 
+// SignIndex locates the consensus sign of a method and input hash and the role voting on it
+type SignIndex struct {
+	Hash common.Hash
+	Role string
+}
+
+// SignProgress is the signers of a consensus sign so far and the quorum of its role in the current epoch
+type SignProgress struct {
+	Signers []common.Address
+	Quorum  uint64
+}
+
+func (m *SignProgress) Decode(payload []byte) error {
+	var data struct {
+		SignProgress []byte
+	}
+	if err := contract.UnpackOutputs(ABI, MethodGetSignProgress, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.SignProgress, m)
+}
+
+// Evidence is misbehaviour of a signer, Data is encoded by the reporting module
+type Evidence struct {
+	Module common.Address
+	Kind   string
+	Height uint64
+	Data   []byte
+}
+
+func (m *Evidence) Decode(payload []byte) error {
+	var data struct {
+		Evidence []byte
+	}
+	if err := contract.UnpackOutputs(ABI, MethodGetEvidence, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Evidence, m)
+}
+
 type CommunityInfo struct {
 	CommunityRate    *big.Int
 	CommunityAddress common.Address
@@ -20,6 +20,7 @@ package node_manager
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -76,6 +77,9 @@ func CheckConsensusSigns(s *contract.ModuleContract, method string, input []byte
 			} else {
 				log.Trace("checkConsensusSign", "store sign, hash", sign.Hash().Hex())
 			}
+			if err := storeSignIndex(s, method, input, &SignIndex{sign.Hash(), string(signerName)}); err != nil {
+				return false, fmt.Errorf("CheckConsensusSigns, storeSignIndex error: %v, hash %s", err, sign.Hash().Hex())
+			}
 		} else {
 			return false, fmt.Errorf("CheckConsensusSigns, get sign error: %v, hash %s", err, sign.Hash().Hex())
 		}
@@ -105,6 +109,29 @@ func CheckConsensusSigns(s *contract.ModuleContract, method string, input []byte
 	return sizeAfterSign >= quorum, nil
 }
 
+// RevokeConsensusSign removes the sign of a signer from the consensus sign of a method and input. It is meant
+// for inputs whose quorum has not been reached, a module revoking a sign has to make sure of that itself
+func RevokeConsensusSign(s *contract.ModuleContract, method string, input []byte, signer common.Address) error {
+	sign := &ConsensusSign{Method: method, Input: input}
+	if err := removeSigner(s, sign.Hash(), signer); err != nil {
+		return fmt.Errorf("RevokeConsensusSign, removeSigner error: %v, hash %s", err, sign.Hash().Hex())
+	}
+	return nil
+}
+
+// QuorumSize returns the number of signs a role needs to reach consensus in an epoch
+func QuorumSize(epoch *EpochInfo, signerName SignerName) int {
+	switch signerName {
+	case Signer:
+		return epoch.SignerQuorumSize()
+	case Voter:
+		return epoch.VoterQuorumSize()
+	case Proposer:
+		return epoch.ProposerQuorumSize()
+	}
+	return 0
+}
+
 func CheckSignerAuthority(origin, caller common.Address, epoch *EpochInfo) error {
 	if epoch == nil || epoch.Signers == nil {
 		return fmt.Errorf("invalid epoch")
@@ -153,6 +180,27 @@ func CheckProposerAuthority(addr common.Address, epoch *EpochInfo) error {
 	return fmt.Errorf("addr %s is not valid proposer", addr.Hex())
 }
 
+// GetSignerStakes returns the total stake of validators by their signer address,
+// voters of the genesis epoch without a validator have no stake
+func GetSignerStakes(s *contract.ModuleContract) (map[common.Address]*big.Int, error) {
+	allValidators, err := getAllValidators(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetSignerStakes, getAllValidators error: %v", err)
+	}
+	stakes := make(map[common.Address]*big.Int, len(allValidators.AllValidators))
+	for _, consensusAddr := range allValidators.AllValidators {
+		validator, found, err := getValidator(s, consensusAddr)
+		if err != nil {
+			return nil, fmt.Errorf("GetSignerStakes, getValidator error: %v", err)
+		}
+		if !found || validator.TotalStake.I == nil {
+			continue
+		}
+		stakes[validator.SignerAddress] = validator.TotalStake.I
+	}
+	return stakes, nil
+}
+
 func EpochChangeAtNextBlock(curHeight, epochStartHeight uint64) bool {
 	return curHeight+1 == epochStartHeight
 }
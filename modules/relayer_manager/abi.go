@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/polynetwork/zion-example/modules/cfg"
+	. "github.com/polynetwork/zion-example/modules/go_abi/relayer_manager_abi"
+)
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader(IRelayerManagerABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+var (
+	ABI  *abi.ABI
+	this = cfg.RelayerManagerContractAddress
+)
+
+type RegisterRelayerParam struct{}
+
+func (m *RegisterRelayerParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodRegisterRelayer)
+}
+
+type UnregisterRelayerParam struct{}
+
+func (m *UnregisterRelayerParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodUnregisterRelayer)
+}
+
+type WithdrawStakeParam struct{}
+
+func (m *WithdrawStakeParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodWithdrawStake)
+}
+
+type DepositRewardsParam struct{}
+
+func (m *DepositRewardsParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodDepositRewards)
+}
+
+type WithdrawRewardsParam struct{}
+
+func (m *WithdrawRewardsParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodWithdrawRewards)
+}
+
+type SlashRelayerParam struct {
+	Relayer  common.Address
+	Amount   *big.Int
+	Evidence []byte
+}
+
+func (m *SlashRelayerParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodSlashRelayer, m)
+}
+
+type SetRelayerParamsParam struct {
+	MinStake        *big.Int
+	UnbondingPeriod uint64
+	Reward          *big.Int
+	Whitelist       bool
+}
+
+func (m *SetRelayerParamsParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodSetRelayerParams, m)
+}
+
+type GetRelayerParam struct {
+	Relayer common.Address
+}
+
+func (m *GetRelayerParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, MethodGetRelayer, m)
+}
+
+type GetRelayerParamsParam struct{}
+
+func (m *GetRelayerParamsParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodGetRelayerParams)
+}
+
+type GetRewardPoolParam struct{}
+
+func (m *GetRewardPoolParam) Encode() ([]byte, error) {
+	return contract.PackMethod(ABI, MethodGetRewardPool)
+}
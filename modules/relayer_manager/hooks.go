@@ -0,0 +1,85 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+)
+
+// CheckRelayer guards the relayer methods of other modules, once the whitelist is enabled only active
+// relayers may call them
+func CheckRelayer(module *contract.ModuleContract, address common.Address) error {
+	relayerParams, err := GetRelayerParamsObj(module)
+	if err != nil {
+		return fmt.Errorf("CheckRelayer, %v", err)
+	}
+	if !relayerParams.Whitelist {
+		return nil
+	}
+	relayer, err := GetRelayerObj(module, address)
+	if err != nil {
+		return fmt.Errorf("CheckRelayer, %v", err)
+	}
+	if relayer == nil || !relayer.Active(relayerParams.MinStake) {
+		return fmt.Errorf("CheckRelayer, %s is not an active relayer", address.Hex())
+	}
+	return nil
+}
+
+// RecordSubmission counts a submission of a relayer to another module, an active relayer is rewarded for it while
+// the reward pool lasts. A call that submits or requests nothing new, like replayed calldata, a repeated vote or a
+// replenish of nothing missing, does not make progress and is counted as a failure without reward. Calls that fail
+// revert and leave no record, misbehaving relayers are slashed instead. Submissions of addresses that are not
+// registered are ignored
+func RecordSubmission(module *contract.ModuleContract, address common.Address, progress bool) error {
+	relayer, err := GetRelayerObj(module, address)
+	if err != nil {
+		return fmt.Errorf("RecordSubmission, %v", err)
+	}
+	if relayer == nil {
+		return nil
+	}
+	if !progress {
+		relayer.Failures++
+		return putRelayer(module, relayer)
+	}
+
+	relayer.Successes++
+	relayerParams, err := GetRelayerParamsObj(module)
+	if err != nil {
+		return fmt.Errorf("RecordSubmission, %v", err)
+	}
+	if relayerParams.Reward.Sign() > 0 && relayer.Active(relayerParams.MinStake) {
+		pool, err := getRewardPool(module)
+		if err != nil {
+			return fmt.Errorf("RecordSubmission, %v", err)
+		}
+		if pool.Cmp(relayerParams.Reward) >= 0 {
+			relayer.Rewards = new(big.Int).Add(relayer.Rewards, relayerParams.Reward)
+			if err := putRewardPool(module, new(big.Int).Sub(pool, relayerParams.Reward)); err != nil {
+				return fmt.Errorf("RecordSubmission, putRewardPool error: %v", err)
+			}
+		}
+	}
+	return putRelayer(module, relayer)
+}
@@ -0,0 +1,340 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	. "github.com/polynetwork/zion-example/modules/go_abi/relayer_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+const MaxEvidenceLength int = 4000
+
+func InitRelayerManager() {
+	InitABI()
+	contract.Contracts.RegisterContract(this, RegisterRelayerManagerContract)
+}
+
+func RegisterRelayerManagerContract(s *contract.ModuleContract) {
+	s.Prepare(ABI)
+
+	s.Register(MethodRegisterRelayer, RegisterRelayer)
+	s.Register(MethodUnregisterRelayer, UnregisterRelayer)
+	s.Register(MethodWithdrawStake, WithdrawStake)
+	s.Register(MethodDepositRewards, DepositRewards)
+	s.Register(MethodWithdrawRewards, WithdrawRewards)
+	s.Register(MethodSlashRelayer, SlashRelayer)
+	s.Register(MethodSetRelayerParams, SetRelayerParams)
+	s.Register(MethodGetRelayer, GetRelayer)
+	s.Register(MethodGetRelayerParams, GetRelayerParams)
+	s.Register(MethodGetRewardPool, GetRewardPool)
+}
+
+// RegisterRelayer registers the caller with the value sent as stake, or adds the value to the stake of a
+// registered relayer
+func RegisterRelayer(s *contract.ModuleContract) ([]byte, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	if caller != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("RegisterRelayer, contract call forbidden")
+	}
+	value := s.ContractRef().Value()
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	relayerParams, err := GetRelayerParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRelayer, GetRelayerParamsObj error: %v", err)
+	}
+	relayer, err := GetRelayerObj(s, caller)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRelayer, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil {
+		relayer = &Relayer{Address: caller, Stake: new(big.Int), Rewards: new(big.Int), Slashed: new(big.Int)}
+	} else if relayer.UnbondHeight != 0 {
+		return nil, fmt.Errorf("RegisterRelayer, relayer %s is unbonding, withdraw the stake first", caller.Hex())
+	} else if value.Sign() == 0 {
+		return nil, fmt.Errorf("RegisterRelayer, relayer %s is already registered", caller.Hex())
+	}
+	relayer.Stake = new(big.Int).Add(relayer.Stake, value)
+	if relayer.Stake.Cmp(relayerParams.MinStake) < 0 {
+		return nil, fmt.Errorf("RegisterRelayer, stake %s is less than min stake %s", relayer.Stake, relayerParams.MinStake)
+	}
+	if err := putRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("RegisterRelayer, putRelayer error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventRelayerRegistered}, caller, relayer.Stake)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterRelayer, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodRegisterRelayer, true)
+}
+
+// UnregisterRelayer stops the caller from relaying, its stake stays slashable for the unbonding period
+func UnregisterRelayer(s *contract.ModuleContract) ([]byte, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	relayer, err := GetRelayerObj(s, caller)
+	if err != nil {
+		return nil, fmt.Errorf("UnregisterRelayer, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil || relayer.UnbondHeight != 0 {
+		return nil, fmt.Errorf("UnregisterRelayer, relayer %s is not registered", caller.Hex())
+	}
+	relayerParams, err := GetRelayerParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("UnregisterRelayer, GetRelayerParamsObj error: %v", err)
+	}
+
+	relayer.UnbondHeight = s.ContractRef().BlockHeight().Uint64() + relayerParams.UnbondingPeriod
+	if err := putRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("UnregisterRelayer, putRelayer error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventRelayerUnregistered}, caller, relayer.UnbondHeight)
+	if err != nil {
+		return nil, fmt.Errorf("UnregisterRelayer, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodUnregisterRelayer, true)
+}
+
+// WithdrawStake pays out the stake and rewards of an unregistered relayer once it is unbonded and removes it
+func WithdrawStake(s *contract.ModuleContract) ([]byte, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	relayer, err := GetRelayerObj(s, caller)
+	if err != nil {
+		return nil, fmt.Errorf("WithdrawStake, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil || relayer.UnbondHeight == 0 {
+		return nil, fmt.Errorf("WithdrawStake, relayer %s is not unregistered", caller.Hex())
+	}
+	if height := s.ContractRef().BlockHeight().Uint64(); height < relayer.UnbondHeight {
+		return nil, fmt.Errorf("WithdrawStake, stake is unbonding until height %d, current %d", relayer.UnbondHeight, height)
+	}
+
+	if err := payRewards(s, relayer); err != nil {
+		return nil, fmt.Errorf("WithdrawStake, %v", err)
+	}
+	delRelayer(s, caller)
+	if err := utils.ModuleTransfer(s.StateDB(), this, caller, relayer.Stake); err != nil {
+		return nil, fmt.Errorf("WithdrawStake, utils.ModuleTransfer error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventStakeWithdrawn}, caller, relayer.Stake)
+	if err != nil {
+		return nil, fmt.Errorf("WithdrawStake, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodWithdrawStake, true)
+}
+
+// DepositRewards adds the value sent to the pool successful submissions are rewarded from, the pool is only
+// funded by explicit deposits
+func DepositRewards(s *contract.ModuleContract) ([]byte, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	value := s.ContractRef().Value()
+	if value == nil || value.Sign() <= 0 {
+		return nil, fmt.Errorf("DepositRewards, no value is sent")
+	}
+
+	pool, err := getRewardPool(s)
+	if err != nil {
+		return nil, fmt.Errorf("DepositRewards, %v", err)
+	}
+	if err := putRewardPool(s, new(big.Int).Add(pool, value)); err != nil {
+		return nil, fmt.Errorf("DepositRewards, putRewardPool error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventRewardsDeposited}, caller, value)
+	if err != nil {
+		return nil, fmt.Errorf("DepositRewards, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodDepositRewards, true)
+}
+
+func WithdrawRewards(s *contract.ModuleContract) ([]byte, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	relayer, err := GetRelayerObj(s, caller)
+	if err != nil {
+		return nil, fmt.Errorf("WithdrawRewards, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil {
+		return nil, fmt.Errorf("WithdrawRewards, relayer %s is not registered", caller.Hex())
+	}
+	if relayer.Rewards.Sign() == 0 {
+		return nil, fmt.Errorf("WithdrawRewards, relayer %s has no rewards", caller.Hex())
+	}
+
+	if err := payRewards(s, relayer); err != nil {
+		return nil, fmt.Errorf("WithdrawRewards, %v", err)
+	}
+	if err := putRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("WithdrawRewards, putRelayer error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodWithdrawRewards, true)
+}
+
+func payRewards(s *contract.ModuleContract, relayer *Relayer) error {
+	if relayer.Rewards.Sign() == 0 {
+		return nil
+	}
+	amount := relayer.Rewards
+	relayer.Rewards = new(big.Int)
+	if err := utils.ModuleTransfer(s.StateDB(), this, relayer.Address, amount); err != nil {
+		return fmt.Errorf("utils.ModuleTransfer rewards error: %v", err)
+	}
+	if err := s.AddNotify(ABI, []string{EventRewardsWithdrawn}, relayer.Address, amount); err != nil {
+		return fmt.Errorf("AddNotify error: %v", err)
+	}
+	return nil
+}
+
+// SlashRelayer moves up to amount of the stake of a relayer to the community pool once a signer quorum votes
+// for the same slash. Evidence is the provably bad submission, it is not interpreted by this module
+func SlashRelayer(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &SlashRelayerParam{}
+	if err := contract.UnpackMethod(ABI, MethodSlashRelayer, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("SlashRelayer, unpack params error: %v", err)
+	}
+	if len(params.Evidence) > MaxEvidenceLength {
+		return nil, fmt.Errorf("SlashRelayer, evidence is more than max length %d", MaxEvidenceLength)
+	}
+	relayer, err := GetRelayerObj(s, params.Relayer)
+	if err != nil {
+		return nil, fmt.Errorf("SlashRelayer, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil {
+		return nil, fmt.Errorf("SlashRelayer, relayer %s is not registered", params.Relayer.Hex())
+	}
+
+	blob, err := rlp.EncodeToBytes(&SlashVote{params.Relayer, params.Amount, params.Evidence, relayer.Slashes})
+	if err != nil {
+		return nil, fmt.Errorf("SlashRelayer, rlp.EncodeToBytes slash vote error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSlashRelayer, blob, s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SlashRelayer, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, MethodSlashRelayer, true)
+	}
+
+	amount := params.Amount
+	if amount.Cmp(relayer.Stake) > 0 {
+		amount = relayer.Stake
+	}
+	relayer.Stake = new(big.Int).Sub(relayer.Stake, amount)
+	relayer.Slashed = new(big.Int).Add(relayer.Slashed, amount)
+	relayer.Slashes++
+	if err := putRelayer(s, relayer); err != nil {
+		return nil, fmt.Errorf("SlashRelayer, putRelayer error: %v", err)
+	}
+	communityInfo, err := node_manager.GetCommunityInfoImpl(s)
+	if err != nil {
+		return nil, fmt.Errorf("SlashRelayer, node_manager.GetCommunityInfoImpl error: %v", err)
+	}
+	if err := utils.ModuleTransfer(s.StateDB(), this, communityInfo.CommunityAddress, amount); err != nil {
+		return nil, fmt.Errorf("SlashRelayer, utils.ModuleTransfer error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventRelayerSlashed}, params.Relayer, amount, params.Evidence)
+	if err != nil {
+		return nil, fmt.Errorf("SlashRelayer, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodSlashRelayer, true)
+}
+
+// SetRelayerParams changes the relayer params once a signer quorum votes for the same params
+func SetRelayerParams(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &SetRelayerParamsParam{}
+	if err := contract.UnpackMethod(ABI, MethodSetRelayerParams, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, unpack params error: %v", err)
+	}
+	old, err := GetRelayerParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, GetRelayerParamsObj error: %v", err)
+	}
+	relayerParams := &RelayerParams{params.MinStake, params.UnbondingPeriod, params.Reward, params.Whitelist, old.Revision}
+	blob, err := rlp.EncodeToBytes(relayerParams)
+	if err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, rlp.EncodeToBytes relayer params error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, MethodSetRelayerParams, blob, s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, MethodSetRelayerParams, true)
+	}
+
+	relayerParams.Revision = relayerParams.Revision + 1
+	if err := putRelayerParams(s, relayerParams); err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, putRelayerParams error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventRelayerParamsUpdated}, relayerParams.MinStake, relayerParams.UnbondingPeriod,
+		relayerParams.Reward, relayerParams.Whitelist)
+	if err != nil {
+		return nil, fmt.Errorf("SetRelayerParams, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodSetRelayerParams, true)
+}
+
+func GetRelayer(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &GetRelayerParam{}
+	if err := contract.UnpackMethod(ABI, MethodGetRelayer, params, ctx.Payload); err != nil {
+		return nil, fmt.Errorf("GetRelayer, unpack params error: %v", err)
+	}
+	relayer, err := GetRelayerObj(s, params.Relayer)
+	if err != nil {
+		return nil, fmt.Errorf("GetRelayer, GetRelayerObj error: %v", err)
+	}
+	if relayer == nil {
+		return nil, fmt.Errorf("GetRelayer, relayer %s is not registered", params.Relayer.Hex())
+	}
+	blob, err := rlp.EncodeToBytes(relayer)
+	if err != nil {
+		return nil, fmt.Errorf("GetRelayer, serialize relayer error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetRelayer, blob)
+}
+
+func GetRelayerParams(s *contract.ModuleContract) ([]byte, error) {
+	relayerParams, err := GetRelayerParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetRelayerParams, GetRelayerParamsObj error: %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetRelayerParams, relayerParams.MinStake, relayerParams.UnbondingPeriod,
+		relayerParams.Reward, relayerParams.Whitelist)
+}
+
+func GetRewardPool(s *contract.ModuleContract) ([]byte, error) {
+	pool, err := getRewardPool(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetRewardPool, %v", err)
+	}
+	return contract.PackOutputs(ABI, MethodGetRewardPool, pool)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/polynetwork/zion-example/modules/cfg"
+	. "github.com/polynetwork/zion-example/modules/go_abi/relayer_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sdb     *state.StateDB
+	signers []common.Address
+)
+
+func init() {
+	node_manager.InitNodeManager()
+	InitRelayerManager()
+	sdb = contract.NewTestStateDB()
+	signers, _ = contract.GenerateTestPeers(2)
+	node_manager.StoreGenesisEpoch(sdb, signers, signers)
+}
+
+func callRelayerManager(caller common.Address, height int64, value *big.Int, input []byte) ([]byte, error) {
+	contractRef := contract.NewContractRef(sdb, caller, caller, big.NewInt(height), common.Hash{}, uint64(2100000000), nil)
+	if value != nil {
+		contractRef.SetValue(value)
+		if err := utils.ModuleTransfer(sdb, caller, cfg.RelayerManagerContractAddress, value); err != nil {
+			return nil, err
+		}
+	}
+	ret, _, err := contractRef.ModuleCall(caller, cfg.RelayerManagerContractAddress, input)
+	return ret, err
+}
+
+func vote(t *testing.T, input []byte) {
+	for _, signer := range signers {
+		_, err := callRelayerManager(signer, 1, nil, input)
+		assert.Nil(t, err)
+	}
+}
+
+func getRelayer(t *testing.T, address common.Address) *Relayer {
+	input, err := (&GetRelayerParam{address}).Encode()
+	assert.Nil(t, err)
+	ret, err := callRelayerManager(address, 1, nil, input)
+	assert.Nil(t, err)
+	relayer := new(Relayer)
+	assert.Nil(t, relayer.Decode(ret))
+	return relayer
+}
+
+func TestRelayerRegistry(t *testing.T) {
+	relayer := common.HexToAddress("0x1001")
+	sdb.AddBalance(relayer, big.NewInt(10000))
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, relayer, relayer, big.NewInt(1), common.Hash{}, 0, nil))
+
+	input, err := (&SetRelayerParamsParam{big.NewInt(1000), 10, big.NewInt(100), true}).Encode()
+	assert.Nil(t, err)
+	vote(t, input)
+	input, err = (&GetRelayerParamsParam{}).Encode()
+	assert.Nil(t, err)
+	ret, err := callRelayerManager(relayer, 1, nil, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, MethodGetRelayerParams, big.NewInt(1000), uint64(10), big.NewInt(100), true)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+
+	// the whitelist only lets registered relayers staked at least min stake through
+	assert.NotNil(t, CheckRelayer(c, relayer))
+	register, err := (&RegisterRelayerParam{}).Encode()
+	assert.Nil(t, err)
+	_, err = callRelayerManager(relayer, 1, big.NewInt(999), register)
+	assert.NotNil(t, err)
+	sdb.AddBalance(relayer, big.NewInt(999))
+	_, err = callRelayerManager(relayer, 1, big.NewInt(1000), register)
+	assert.Nil(t, err)
+	assert.Nil(t, CheckRelayer(c, relayer))
+	assert.Equal(t, big.NewInt(9000), sdb.GetBalance(relayer))
+
+	// submissions that make progress are rewarded while the pool lasts, the others are counted as failures
+	deposit, err := (&DepositRewardsParam{}).Encode()
+	assert.Nil(t, err)
+	_, err = callRelayerManager(signers[0], 1, big.NewInt(150), deposit)
+	assert.Nil(t, err)
+	assert.Nil(t, RecordSubmission(c, relayer, true))
+	assert.Nil(t, RecordSubmission(c, relayer, true))
+	assert.Nil(t, RecordSubmission(c, relayer, false))
+	assert.Nil(t, RecordSubmission(c, common.HexToAddress("0x1002"), true))
+	record := getRelayer(t, relayer)
+	assert.Equal(t, uint64(2), record.Successes)
+	assert.Equal(t, uint64(1), record.Failures)
+	assert.Equal(t, big.NewInt(100), record.Rewards)
+	pool, err := getRewardPool(c)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), pool)
+
+	// a slash takes effect once a signer quorum votes for it, the same slash can be voted again after
+	slash, err := (&SlashRelayerParam{relayer, big.NewInt(600), []byte("bad proof")}).Encode()
+	assert.Nil(t, err)
+	_, err = callRelayerManager(signers[0], 1, nil, slash)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), getRelayer(t, relayer).Stake)
+	_, err = callRelayerManager(signers[1], 1, nil, slash)
+	assert.Nil(t, err)
+	record = getRelayer(t, relayer)
+	assert.Equal(t, big.NewInt(400), record.Stake)
+	assert.Equal(t, big.NewInt(600), record.Slashed)
+	assert.Equal(t, uint64(1), record.Slashes)
+	assert.NotNil(t, CheckRelayer(c, relayer))
+	vote(t, slash)
+	record = getRelayer(t, relayer)
+	assert.Equal(t, new(big.Int), record.Stake)
+	assert.Equal(t, big.NewInt(1000), record.Slashed)
+
+	// the stake is withdrawn with the rewards after the unbonding period
+	_, err = callRelayerManager(relayer, 1, big.NewInt(1000), register)
+	assert.Nil(t, err)
+	unregister, err := (&UnregisterRelayerParam{}).Encode()
+	assert.Nil(t, err)
+	_, err = callRelayerManager(relayer, 5, nil, unregister)
+	assert.Nil(t, err)
+	assert.NotNil(t, CheckRelayer(c, relayer))
+	withdraw, err := (&WithdrawStakeParam{}).Encode()
+	assert.Nil(t, err)
+	_, err = callRelayerManager(relayer, 14, nil, withdraw)
+	assert.NotNil(t, err)
+	_, err = callRelayerManager(relayer, 15, nil, withdraw)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(9100), sdb.GetBalance(relayer))
+	record, err = GetRelayerObj(c, relayer)
+	assert.Nil(t, err)
+	assert.Nil(t, record)
+
+	input, err = (&SetRelayerParamsParam{new(big.Int), 0, new(big.Int), false}).Encode()
+	assert.Nil(t, err)
+	vote(t, input)
+	assert.Nil(t, CheckRelayer(c, relayer))
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	SKP_RELAYER        = "st_relayer"
+	SKP_RELAYER_PARAMS = "st_relayer_params"
+	SKP_REWARD_POOL    = "st_reward_pool"
+)
+
+// GetRelayerObj returns the record of a relayer, nil if it never registered or withdrew its stake
+func GetRelayerObj(module *contract.ModuleContract, address common.Address) (*Relayer, error) {
+	store, err := module.GetCacheDB().Get(relayerKey(address))
+	if err != nil {
+		return nil, fmt.Errorf("GetRelayerObj, get relayer store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	relayer := new(Relayer)
+	if err := rlp.DecodeBytes(store, relayer); err != nil {
+		return nil, fmt.Errorf("GetRelayerObj, deserialize relayer error: %v", err)
+	}
+	return relayer, nil
+}
+
+func putRelayer(module *contract.ModuleContract, relayer *Relayer) error {
+	blob, err := rlp.EncodeToBytes(relayer)
+	if err != nil {
+		return fmt.Errorf("putRelayer, rlp.EncodeToBytes relayer error: %v", err)
+	}
+	return module.GetCacheDB().Put(relayerKey(relayer.Address), blob)
+}
+
+func delRelayer(module *contract.ModuleContract, address common.Address) {
+	module.GetCacheDB().Delete(relayerKey(address))
+}
+
+// GetRelayerParamsObj returns the relayer params, no stake is required and no method is guarded before they are set
+func GetRelayerParamsObj(module *contract.ModuleContract) (*RelayerParams, error) {
+	store, err := module.GetCacheDB().Get(relayerParamsKey())
+	if err != nil {
+		return nil, fmt.Errorf("GetRelayerParamsObj, get relayer params store error: %v", err)
+	}
+	relayerParams := &RelayerParams{
+		MinStake: new(big.Int),
+		Reward:   new(big.Int),
+	}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, relayerParams); err != nil {
+			return nil, fmt.Errorf("GetRelayerParamsObj, deserialize relayer params error: %v", err)
+		}
+	}
+	return relayerParams, nil
+}
+
+func putRelayerParams(module *contract.ModuleContract, relayerParams *RelayerParams) error {
+	blob, err := rlp.EncodeToBytes(relayerParams)
+	if err != nil {
+		return fmt.Errorf("putRelayerParams, rlp.EncodeToBytes relayer params error: %v", err)
+	}
+	return module.GetCacheDB().Put(relayerParamsKey(), blob)
+}
+
+// getRewardPool returns the deposited rewards that are not credited to relayers yet
+func getRewardPool(module *contract.ModuleContract) (*big.Int, error) {
+	store, err := module.GetCacheDB().Get(rewardPoolKey())
+	if err != nil {
+		return nil, fmt.Errorf("getRewardPool, get reward pool store error: %v", err)
+	}
+	return new(big.Int).SetBytes(store), nil
+}
+
+func putRewardPool(module *contract.ModuleContract, pool *big.Int) error {
+	return module.GetCacheDB().Put(rewardPoolKey(), pool.Bytes())
+}
+
+func relayerKey(address common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_RELAYER), address[:])
+}
+
+func relayerParamsKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_RELAYER_PARAMS))
+}
+
+func rewardPoolKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_REWARD_POOL))
+}
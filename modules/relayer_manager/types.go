@@ -0,0 +1,78 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package relayer_manager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/rlp"
+	. "github.com/polynetwork/zion-example/modules/go_abi/relayer_manager_abi"
+)
+
+type Relayer struct {
+	Address common.Address
+	Stake   *big.Int
+	// submissions of other modules that made progress, and those that submitted or requested nothing new
+	Successes uint64
+	Failures  uint64
+	// rewards not withdrawn yet
+	Rewards *big.Int
+	// slashed in total, Slashes also salts slash votes
+	Slashed *big.Int
+	Slashes uint64
+	// 0 while registered, the height the stake can be withdrawn at after unregistering
+	UnbondHeight uint64
+}
+
+// Active tells whether the relayer is registered and staked at least minStake
+func (m *Relayer) Active(minStake *big.Int) bool {
+	return m.UnbondHeight == 0 && m.Stake.Cmp(minStake) >= 0
+}
+
+func (m *Relayer) Decode(payload []byte) error {
+	var data struct {
+		Relayer []byte
+	}
+	if err := contract.UnpackOutputs(ABI, MethodGetRelayer, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Relayer, m)
+}
+
+type RelayerParams struct {
+	MinStake *big.Int
+	// blocks an unregistered relayer stays slashable before the stake can be withdrawn
+	UnbondingPeriod uint64
+	// paid from the reward pool for every successful submission
+	Reward *big.Int
+	// only active relayers may call the methods other modules guard with CheckRelayer
+	Whitelist bool
+	// bumped on every change so signers can vote for the same params again
+	Revision uint64
+}
+
+// SlashVote is what signers vote on to slash a relayer, Slashes makes votes of one slash unique
+type SlashVote struct {
+	Relayer  common.Address
+	Amount   *big.Int
+	Evidence []byte
+	Slashes  uint64
+}
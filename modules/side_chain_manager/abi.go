@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -37,6 +38,20 @@ var (
 	EventApproveUpdateSideChain   = side_chain_manager_abi.EventApproveUpdateSideChain
 	EventQuitSideChain            = side_chain_manager_abi.EventQuitSideChain
 	EventApproveQuitSideChain     = side_chain_manager_abi.EventApproveQuitSideChain
+	EventCancelRequest            = side_chain_manager_abi.EventCancelRequest
+	EventRejectRequest            = side_chain_manager_abi.EventRejectRequest
+	EventRequestExpired           = side_chain_manager_abi.EventRequestExpired
+	EventOwnershipTransferStarted = side_chain_manager_abi.EventOwnershipTransferStarted
+	EventOwnershipTransferred     = side_chain_manager_abi.EventOwnershipTransferred
+	EventOwnershipRecovered       = side_chain_manager_abi.EventOwnershipRecovered
+	EventAssetApproved            = side_chain_manager_abi.EventAssetApproved
+	EventAssetRemoved             = side_chain_manager_abi.EventAssetRemoved
+	EventSideChainStatusChanged   = side_chain_manager_abi.EventSideChainStatusChanged
+	EventFeeUpdated               = side_chain_manager_abi.EventFeeUpdated
+	EventFeeParamsUpdated         = side_chain_manager_abi.EventFeeParamsUpdated
+	EventBondParamsUpdated        = side_chain_manager_abi.EventBondParamsUpdated
+	EventBondRefunded             = side_chain_manager_abi.EventBondRefunded
+	EventBondForfeited            = side_chain_manager_abi.EventBondForfeited
 )
 
 func GetABI() *abi.ABI {
@@ -59,10 +74,76 @@ func (m *RegisterSideChainParam) Encode() ([]byte, error) {
 	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodRegisterSideChain, m)
 }
 
+// UpdateSideChainParam is an update request, the new config is used for side chain heights from ActivationHeight
+type UpdateSideChainParam struct {
+	ChainID          uint64
+	Router           uint64
+	Name             string
+	CCMCAddress      []byte
+	ExtraInfo        []byte
+	ActivationHeight uint64
+}
+
+func (m *UpdateSideChainParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodUpdateSideChain, m)
+}
+
 type ChainIDParam struct {
 	ChainID uint64
 }
 
+type HeightParam struct {
+	ChainID uint64
+	Height  uint64
+}
+
+type PageParam struct {
+	Offset uint64
+	Limit  uint64
+}
+
+func (m *PageParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetAllSideChains, m)
+}
+
+type StatusParam struct {
+	ChainID uint64
+	Status  uint8
+}
+
+func (m *StatusParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodSetSideChainStatus, m)
+}
+
+type BondParamsParam struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+}
+
+func (m *BondParamsParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodSetBondParams, m)
+}
+
+type RequestParam struct {
+	ChainID     uint64
+	RequestType uint8
+}
+
+type RequestPageParam struct {
+	RequestType uint8
+	Offset      uint64
+	Limit       uint64
+}
+
+func (m *RequestPageParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetPendingRequests, m)
+}
+
+type OwnershipParam struct {
+	ChainID  uint64
+	NewOwner common.Address
+}
+
 type UpdateFeeParam struct {
 	ChainID   uint64
 	ViewNum   uint64
@@ -89,6 +170,24 @@ func (m *UpdateFeeParam) Digest() ([]byte, error) {
 	return digest, nil
 }
 
+type FeeParamsParam struct {
+	ChainID       uint64
+	Multiplier    uint64
+	MinFee        *big.Int
+	MaxFee        *big.Int
+	Timeout       uint64
+	StakeWeighted bool
+}
+
+func (m *FeeParamsParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodSetFeeParams, m)
+}
+
+type FeeViewParam struct {
+	ChainID uint64
+	ViewNum uint64
+}
+
 type RegisterAssetParam struct {
 	ChainID           uint64
 	AssetMapKey       []uint64
@@ -100,3 +199,30 @@ type RegisterAssetParam struct {
 func (m *RegisterAssetParam) Encode() ([]byte, error) {
 	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodRegisterAsset, m)
 }
+
+type AssetParam struct {
+	ChainID  uint64
+	Asset    []byte
+	Symbol   string
+	Decimals uint8
+	Mode     uint8
+}
+
+func (m *AssetParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveAsset, m)
+}
+
+type AssetKeyParam struct {
+	ChainID uint64
+	Asset   []byte
+}
+
+type AssetPageParam struct {
+	ChainID uint64
+	Offset  uint64
+	Limit   uint64
+}
+
+func (m *AssetPageParam) Encode() ([]byte, error) {
+	return contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetAssets, m)
+}
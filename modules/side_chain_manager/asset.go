@@ -0,0 +1,399 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+// modes of registered assets
+const (
+	// the asset is locked in a vault of the chain and unlocked when it comes back
+	ASSET_MODE_LOCK uint8 = iota
+	// the asset is minted on the chain and burned when it leaves
+	ASSET_MODE_MINT
+)
+
+const (
+	MAX_ASSET_SIZE     = 64
+	MAX_SYMBOL_SIZE    = 32
+	MAX_ASSET_DECIMALS = 36
+)
+
+func (this *AssetParam) validate() error {
+	if len(this.Asset) == 0 || len(this.Asset) > MAX_ASSET_SIZE {
+		return fmt.Errorf("invalid asset size %d, max is %d", len(this.Asset), MAX_ASSET_SIZE)
+	}
+	if len(this.Symbol) == 0 || len(this.Symbol) > MAX_SYMBOL_SIZE {
+		return fmt.Errorf("invalid symbol size %d, max is %d", len(this.Symbol), MAX_SYMBOL_SIZE)
+	}
+	if this.Decimals > MAX_ASSET_DECIMALS {
+		return fmt.Errorf("decimals %d exceed %d", this.Decimals, MAX_ASSET_DECIMALS)
+	}
+	if this.Mode != ASSET_MODE_LOCK && this.Mode != ASSET_MODE_MINT {
+		return fmt.Errorf("unknown asset mode %d", this.Mode)
+	}
+	return nil
+}
+
+// ApproveAsset adds or updates an asset of a side chain once a signer quorum votes for the same entry
+func ApproveAsset(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &AssetParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodApproveAsset, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if err := params.validate(); err != nil {
+		return nil, fmt.Errorf("ApproveAsset, %v", err)
+	}
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveAsset, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("ApproveAsset, side chain %d is not registered", params.ChainID)
+	}
+	if err := checkAssetSymbol(s, params.ChainID, params.Asset, params.Symbol); err != nil {
+		return nil, fmt.Errorf("ApproveAsset, %v", err)
+	}
+
+	entry := &Asset{params.ChainID, params.Asset, params.Symbol, params.Decimals, params.Mode}
+	ok, err := checkAssetSigns(s, side_chain_manager_abi.MethodApproveAsset, entry)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveAsset, %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveAsset, true)
+	}
+
+	old, err := GetAssetEntry(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveAsset, GetAssetEntry error: %v", err)
+	}
+	if old != nil {
+		s.GetCacheDB().Delete(assetSymbolKey(params.ChainID, old.Symbol))
+	}
+	if err := putAssetEntry(s, entry); err != nil {
+		return nil, fmt.Errorf("ApproveAsset, putAssetEntry error: %v", err)
+	}
+	if old == nil {
+		if err := addAssetIndex(s, params.ChainID, params.Asset); err != nil {
+			return nil, fmt.Errorf("ApproveAsset, addAssetIndex error: %v", err)
+		}
+	}
+	err = s.AddNotify(ABI, []string{EventAssetApproved}, entry.ChainID, entry.Asset, entry.Symbol, entry.Decimals, entry.Mode)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveAsset, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveAsset, true)
+}
+
+// RemoveAsset removes an asset of a side chain once a signer quorum votes for it
+func RemoveAsset(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &AssetKeyParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodRemoveAsset, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	entry, err := GetAssetEntry(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveAsset, GetAssetEntry error: %v", err)
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("RemoveAsset, asset %x of chain %d is not registered", params.Asset, params.ChainID)
+	}
+
+	ok, err := checkAssetSigns(s, side_chain_manager_abi.MethodRemoveAsset, entry)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveAsset, %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRemoveAsset, true)
+	}
+
+	if err := deleteAssetEntry(s, entry); err != nil {
+		return nil, fmt.Errorf("RemoveAsset, deleteAssetEntry error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventAssetRemoved}, entry.ChainID, entry.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveAsset, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRemoveAsset, true)
+}
+
+func GetAsset(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &AssetKeyParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetAsset, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	entry, err := GetAssetEntry(s, params.ChainID, params.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("GetAsset, GetAssetEntry error: %v", err)
+	}
+	if entry == nil {
+		entry = &Asset{}
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAsset, entry)
+}
+
+func GetAssets(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &AssetPageParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetAssets, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if params.Limit == 0 || params.Limit > MAX_PAGE_SIZE {
+		return nil, fmt.Errorf("GetAssets, invalid limit, min 1, max %d, current %d", MAX_PAGE_SIZE, params.Limit)
+	}
+	index, err := getAssetIndex(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetAssets, getAssetIndex error: %v", err)
+	}
+	assets := make([]Asset, 0, params.Limit)
+	for i := params.Offset; i < uint64(len(index)) && uint64(len(assets)) < params.Limit; i++ {
+		entry, err := GetAssetEntry(s, params.ChainID, index[i])
+		if err != nil {
+			return nil, fmt.Errorf("GetAssets, GetAssetEntry error: %v", err)
+		}
+		if entry == nil {
+			return nil, fmt.Errorf("GetAssets, indexed asset %x not exist", index[i])
+		}
+		assets = append(assets, *entry)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAssets, assets)
+}
+
+func GetAssetCount(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetAssetCount, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	index, err := getAssetIndex(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetAssetCount, getAssetIndex error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAssetCount, uint64(len(index)))
+}
+
+// checkAssetSigns counts the vote of the tx origin, the revision of the asset makes
+// votes for the same entry possible again after it has been changed
+func checkAssetSigns(s *contract.ModuleContract, method string, entry *Asset) (bool, error) {
+	revision, err := getAssetRevision(s, entry.ChainID, entry.Asset)
+	if err != nil {
+		return false, fmt.Errorf("getAssetRevision error: %v", err)
+	}
+	blob, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		return false, fmt.Errorf("rlp.EncodeToBytes asset error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, method, append(blob, utils.GetUint64Bytes(revision)...),
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return false, fmt.Errorf("CheckConsensusSigns error: %v", err)
+	}
+	return ok, nil
+}
+
+// checkAssetSymbol makes sure a symbol is used by at most one asset of a chain
+func checkAssetSymbol(module *contract.ModuleContract, chainID uint64, asset []byte, symbol string) error {
+	owner, err := module.GetCacheDB().Get(assetSymbolKey(chainID, symbol))
+	if err != nil {
+		return fmt.Errorf("get asset symbol store error: %v", err)
+	}
+	if owner != nil && !bytes.Equal(owner, asset) {
+		return fmt.Errorf("symbol %s is already used by asset %x", symbol, owner)
+	}
+	return nil
+}
+
+// ConvertAmount maps an amount between an asset of chainID and the asset with the same symbol on peerChainID,
+// toPeer converts from the asset to its peer. Amounts are returned unchanged if either side is not registered,
+// dust below the precision of the target asset is dropped
+func ConvertAmount(module *contract.ModuleContract, chainID uint64, asset []byte, peerChainID uint64,
+	amount *big.Int, toPeer bool) (*big.Int, error) {
+	entry, err := GetAssetEntry(module, chainID, asset)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertAmount, GetAssetEntry error: %v", err)
+	}
+	if entry == nil {
+		return amount, nil
+	}
+	peer, err := GetAssetBySymbol(module, peerChainID, entry.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertAmount, GetAssetBySymbol error: %v", err)
+	}
+	if peer == nil {
+		return amount, nil
+	}
+	from, to := entry.Decimals, peer.Decimals
+	if !toPeer {
+		from, to = to, from
+	}
+	return scaleAmount(amount, from, to), nil
+}
+
+func scaleAmount(amount *big.Int, from, to uint8) *big.Int {
+	switch {
+	case to > from:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil)
+		return new(big.Int).Mul(amount, factor)
+	case to < from:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+		return new(big.Int).Quo(amount, factor)
+	default:
+		return new(big.Int).Set(amount)
+	}
+}
+
+func assetKey(chainID uint64, asset []byte) []byte {
+	return utils.ConcatKey(this, []byte(ASSET), utils.GetUint64Bytes(chainID), asset)
+}
+
+func assetSymbolKey(chainID uint64, symbol string) []byte {
+	return utils.ConcatKey(this, []byte(ASSET_SYMBOL), utils.GetUint64Bytes(chainID), []byte(symbol))
+}
+
+func GetAssetEntry(module *contract.ModuleContract, chainID uint64, asset []byte) (*Asset, error) {
+	store, err := module.GetCacheDB().Get(assetKey(chainID, asset))
+	if err != nil {
+		return nil, fmt.Errorf("GetAssetEntry, get asset store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	entry := new(Asset)
+	if err := rlp.DecodeBytes(store, entry); err != nil {
+		return nil, fmt.Errorf("GetAssetEntry, deserialize asset error: %v", err)
+	}
+	return entry, nil
+}
+
+func GetAssetBySymbol(module *contract.ModuleContract, chainID uint64, symbol string) (*Asset, error) {
+	asset, err := module.GetCacheDB().Get(assetSymbolKey(chainID, symbol))
+	if err != nil {
+		return nil, fmt.Errorf("GetAssetBySymbol, get asset symbol store error: %v", err)
+	}
+	if asset == nil {
+		return nil, nil
+	}
+	return GetAssetEntry(module, chainID, asset)
+}
+
+func putAssetEntry(module *contract.ModuleContract, entry *Asset) error {
+	blob, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		return fmt.Errorf("putAssetEntry, rlp.EncodeToBytes asset error: %v", err)
+	}
+	if err := module.GetCacheDB().Put(assetKey(entry.ChainID, entry.Asset), blob); err != nil {
+		return err
+	}
+	if err := module.GetCacheDB().Put(assetSymbolKey(entry.ChainID, entry.Symbol), entry.Asset); err != nil {
+		return err
+	}
+	return bumpAssetRevision(module, entry.ChainID, entry.Asset)
+}
+
+func deleteAssetEntry(module *contract.ModuleContract, entry *Asset) error {
+	module.GetCacheDB().Delete(assetKey(entry.ChainID, entry.Asset))
+	module.GetCacheDB().Delete(assetSymbolKey(entry.ChainID, entry.Symbol))
+	if err := removeAssetIndex(module, entry.ChainID, entry.Asset); err != nil {
+		return err
+	}
+	return bumpAssetRevision(module, entry.ChainID, entry.Asset)
+}
+
+func getAssetRevision(module *contract.ModuleContract, chainID uint64, asset []byte) (uint64, error) {
+	key := utils.ConcatKey(this, []byte(ASSET_REVISION), utils.GetUint64Bytes(chainID), asset)
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return 0, fmt.Errorf("getAssetRevision, get asset revision store error: %v", err)
+	}
+	if store == nil {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(store), nil
+}
+
+func bumpAssetRevision(module *contract.ModuleContract, chainID uint64, asset []byte) error {
+	revision, err := getAssetRevision(module, chainID, asset)
+	if err != nil {
+		return err
+	}
+	key := utils.ConcatKey(this, []byte(ASSET_REVISION), utils.GetUint64Bytes(chainID), asset)
+	return module.GetCacheDB().Put(key, utils.GetUint64Bytes(revision+1))
+}
+
+func getAssetIndex(module *contract.ModuleContract, chainID uint64) ([][]byte, error) {
+	key := utils.ConcatKey(this, []byte(ASSET_INDEX), utils.GetUint64Bytes(chainID))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("getAssetIndex, get asset index store error: %v", err)
+	}
+	index := make([][]byte, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &index); err != nil {
+			return nil, fmt.Errorf("getAssetIndex, deserialize asset index error: %v", err)
+		}
+	}
+	return index, nil
+}
+
+func putAssetIndex(module *contract.ModuleContract, chainID uint64, index [][]byte) error {
+	key := utils.ConcatKey(this, []byte(ASSET_INDEX), utils.GetUint64Bytes(chainID))
+	if len(index) == 0 {
+		module.GetCacheDB().Delete(key)
+		return nil
+	}
+	blob, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return fmt.Errorf("putAssetIndex, rlp.EncodeToBytes asset index error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+func addAssetIndex(module *contract.ModuleContract, chainID uint64, asset []byte) error {
+	index, err := getAssetIndex(module, chainID)
+	if err != nil {
+		return err
+	}
+	return putAssetIndex(module, chainID, append(index, asset))
+}
+
+func removeAssetIndex(module *contract.ModuleContract, chainID uint64, asset []byte) error {
+	index, err := getAssetIndex(module, chainID)
+	if err != nil {
+		return err
+	}
+	for i, v := range index {
+		if bytes.Equal(v, asset) {
+			return putAssetIndex(module, chainID, append(index[:i], index[i+1:]...))
+		}
+	}
+	return nil
+}
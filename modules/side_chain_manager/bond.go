@@ -0,0 +1,208 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+// SetBondParams changes the bonds of register and update requests once a signer quorum votes for the same params
+func SetBondParams(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &BondParamsParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodSetBondParams, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	old, err := GetBondParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("SetBondParams, GetBondParamsObj error: %v", err)
+	}
+	bondParams := &BondParams{params.RegisterBond, params.UpdateBond, old.Revision}
+	blob, err := rlp.EncodeToBytes(bondParams)
+	if err != nil {
+		return nil, fmt.Errorf("SetBondParams, rlp.EncodeToBytes bond params error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodSetBondParams, blob,
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetBondParams, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetBondParams, true)
+	}
+
+	bondParams.Revision = bondParams.Revision + 1
+	if err := putBondParams(s, bondParams); err != nil {
+		return nil, fmt.Errorf("SetBondParams, putBondParams error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventBondParamsUpdated}, bondParams.RegisterBond, bondParams.UpdateBond)
+	if err != nil {
+		return nil, fmt.Errorf("SetBondParams, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetBondParams, true)
+}
+
+func GetBondParams(s *contract.ModuleContract) ([]byte, error) {
+	bondParams, err := GetBondParamsObj(s)
+	if err != nil {
+		return nil, fmt.Errorf("GetBondParams, GetBondParamsObj error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetBondParams, bondParams.RegisterBond, bondParams.UpdateBond)
+}
+
+// GetRequestBond returns the bond held for a pending request, an empty payer if there is none
+func GetRequestBond(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RequestParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetRequestBond, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	bond, err := getBond(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetRequestBond, getBond error: %v", err)
+	}
+	if bond == nil {
+		bond = &Bond{Amount: new(big.Int)}
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetRequestBond, bond.Payer, bond.Amount)
+}
+
+func bondParamsKey() []byte {
+	return utils.ConcatKey(this, []byte(BOND_PARAMS))
+}
+
+// GetBondParamsObj returns the bond params, no bond is required before they are set
+func GetBondParamsObj(module *contract.ModuleContract) (*BondParams, error) {
+	store, err := module.GetCacheDB().Get(bondParamsKey())
+	if err != nil {
+		return nil, fmt.Errorf("GetBondParamsObj, get bond params store error: %v", err)
+	}
+	bondParams := &BondParams{
+		RegisterBond: new(big.Int),
+		UpdateBond:   new(big.Int),
+	}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, bondParams); err != nil {
+			return nil, fmt.Errorf("GetBondParamsObj, deserialize bond params error: %v", err)
+		}
+	}
+	return bondParams, nil
+}
+
+func putBondParams(module *contract.ModuleContract, bondParams *BondParams) error {
+	blob, err := rlp.EncodeToBytes(bondParams)
+	if err != nil {
+		return fmt.Errorf("putBondParams, rlp.EncodeToBytes bond params error: %v", err)
+	}
+	return module.GetCacheDB().Put(bondParamsKey(), blob)
+}
+
+func bondKey(requestType uint8, chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(REQUEST_BOND), []byte{requestType}, utils.GetUint64Bytes(chainID))
+}
+
+func getBond(module *contract.ModuleContract, requestType uint8, chainID uint64) (*Bond, error) {
+	store, err := module.GetCacheDB().Get(bondKey(requestType, chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getBond, get bond store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	bond := new(Bond)
+	if err := rlp.DecodeBytes(store, bond); err != nil {
+		return nil, fmt.Errorf("getBond, deserialize bond error: %v", err)
+	}
+	return bond, nil
+}
+
+// collectBond checks the value sent with a register or update request is the required bond and holds it.
+// A bond still held for the request type belongs to an expired or replaced request and is refunded first
+func collectBond(s *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	bondParams, err := GetBondParamsObj(s)
+	if err != nil {
+		return err
+	}
+	required := new(big.Int)
+	switch requestType {
+	case REGISTER_REQUEST:
+		required = bondParams.RegisterBond
+	case UPDATE_REQUEST:
+		required = bondParams.UpdateBond
+	}
+	value := s.ContractRef().Value()
+	if value == nil {
+		value = new(big.Int)
+	}
+	if value.Cmp(required) != 0 {
+		return fmt.Errorf("bond of %s is required, got %s", required, value)
+	}
+	if err := refundBond(s, requestType, chainID); err != nil {
+		return err
+	}
+	if required.Sign() == 0 {
+		return nil
+	}
+	blob, err := rlp.EncodeToBytes(&Bond{s.ContractRef().TxOrigin(), value})
+	if err != nil {
+		return fmt.Errorf("rlp.EncodeToBytes bond error: %v", err)
+	}
+	return s.GetCacheDB().Put(bondKey(requestType, chainID), blob)
+}
+
+// refundBond returns the bond of a request that is approved, cancelled or expired to its payer
+func refundBond(s *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	bond, err := getBond(s, requestType, chainID)
+	if err != nil || bond == nil {
+		return err
+	}
+	return releaseBond(s, requestType, chainID, bond, bond.Payer, EventBondRefunded)
+}
+
+// forfeitBond sends the bond of a rejected request to the community pool
+func forfeitBond(s *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	bond, err := getBond(s, requestType, chainID)
+	if err != nil || bond == nil {
+		return err
+	}
+	communityInfo, err := node_manager.GetCommunityInfoImpl(s)
+	if err != nil {
+		return fmt.Errorf("node_manager.GetCommunityInfoImpl error: %v", err)
+	}
+	return releaseBond(s, requestType, chainID, bond, communityInfo.CommunityAddress, EventBondForfeited)
+}
+
+func releaseBond(s *contract.ModuleContract, requestType uint8, chainID uint64, bond *Bond, to common.Address, event string) error {
+	s.GetCacheDB().Delete(bondKey(requestType, chainID))
+	if err := utils.ModuleTransfer(s.StateDB(), this, to, bond.Amount); err != nil {
+		return fmt.Errorf("ModuleTransfer bond error: %v", err)
+	}
+	if err := s.AddNotify(ABI, []string{event}, chainID, requestType, bond.Payer, bond.Amount); err != nil {
+		return fmt.Errorf("AddNotify error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ChainCleaner purges the state a module keeps for a quit side chain. It deletes at most limit
+// entries from cursor on and returns the cursor to resume from, the entries used and whether it is done
+type ChainCleaner func(module *contract.ModuleContract, chainID, cursor uint64, limit int) (next uint64, used int, done bool, err error)
+
+type chainCleaner struct {
+	name    string
+	cleaner ChainCleaner
+}
+
+// chainCleaners run in registration order, a cleanup task stores the index of the running cleaner
+var chainCleaners []chainCleaner
+
+// RegisterChainCleaner registers the cleaner of a module, registering the same name again replaces it in place
+func RegisterChainCleaner(name string, cleaner ChainCleaner) {
+	for i, v := range chainCleaners {
+		if v.name == name {
+			chainCleaners[i].cleaner = cleaner
+			return
+		}
+	}
+	chainCleaners = append(chainCleaners, chainCleaner{name, cleaner})
+}
+
+// CleanupTask is the progress of cleaning up a quit side chain
+type CleanupTask struct {
+	Stage  uint64
+	Cursor uint64
+}
+
+// ProcessCleanup runs the chain cleaners of quit side chains in order, deleting at most
+// CLEANUP_BATCH_SIZE entries per block, a chain leaves the queue once all cleaners are done
+func ProcessCleanup(module *contract.ModuleContract) error {
+	queue, err := getChainIndex(module, cleanupQueueKey())
+	if err != nil {
+		return fmt.Errorf("ProcessCleanup, getChainIndex error: %v", err)
+	}
+	budget := CLEANUP_BATCH_SIZE
+	for _, chainID := range queue {
+		if budget <= 0 {
+			break
+		}
+		task, err := getCleanupTask(module, chainID)
+		if err != nil {
+			return fmt.Errorf("ProcessCleanup, getCleanupTask error: %v", err)
+		}
+		for task.Stage < uint64(len(chainCleaners)) && budget > 0 {
+			next, used, done, err := chainCleaners[task.Stage].cleaner(module, chainID, task.Cursor, budget)
+			if err != nil {
+				return fmt.Errorf("ProcessCleanup, cleaner %s of chain %d error: %v", chainCleaners[task.Stage].name, chainID, err)
+			}
+			// every call is charged so that a cleaner making no progress still ends the batch
+			if used < 1 {
+				used = 1
+			}
+			budget -= used
+			if done {
+				task.Stage, task.Cursor = task.Stage+1, 0
+			} else {
+				task.Cursor = next
+			}
+		}
+		if task.Stage < uint64(len(chainCleaners)) {
+			if err := putCleanupTask(module, chainID, task); err != nil {
+				return fmt.Errorf("ProcessCleanup, putCleanupTask error: %v", err)
+			}
+			continue
+		}
+		module.GetCacheDB().Delete(cleanupTaskKey(chainID))
+		if err := removeChainIndex(module, cleanupQueueKey(), chainID); err != nil {
+			return fmt.Errorf("ProcessCleanup, removeChainIndex error: %v", err)
+		}
+	}
+	return nil
+}
+
+func enqueueCleanup(module *contract.ModuleContract, chainID uint64) error {
+	if err := putCleanupTask(module, chainID, &CleanupTask{}); err != nil {
+		return err
+	}
+	return addChainIndex(module, cleanupQueueKey(), chainID)
+}
+
+func isCleanupPending(module *contract.ModuleContract, chainID uint64) (bool, error) {
+	store, err := module.GetCacheDB().Get(cleanupTaskKey(chainID))
+	if err != nil {
+		return false, fmt.Errorf("isCleanupPending, get cleanup task store error: %v", err)
+	}
+	return store != nil, nil
+}
+
+func cleanupQueueKey() []byte {
+	return utils.ConcatKey(this, []byte(CLEANUP_QUEUE))
+}
+
+func cleanupTaskKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(CLEANUP_TASK), utils.GetUint64Bytes(chainID))
+}
+
+func getCleanupTask(module *contract.ModuleContract, chainID uint64) (*CleanupTask, error) {
+	store, err := module.GetCacheDB().Get(cleanupTaskKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getCleanupTask, get cleanup task store error: %v", err)
+	}
+	task := new(CleanupTask)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, task); err != nil {
+			return nil, fmt.Errorf("getCleanupTask, deserialize cleanup task error: %v", err)
+		}
+	}
+	return task, nil
+}
+
+func putCleanupTask(module *contract.ModuleContract, chainID uint64, task *CleanupTask) error {
+	blob, err := rlp.EncodeToBytes(task)
+	if err != nil {
+		return fmt.Errorf("putCleanupTask, rlp.EncodeToBytes cleanup task error: %v", err)
+	}
+	return module.GetCacheDB().Put(cleanupTaskKey(chainID), blob)
+}
+
+// cleanFee deletes fee votes of all views and the fee history. The view is kept and moved past
+// the last one since fee votes of a view are consensus signs that can not be cast again
+func cleanFee(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	fee, err := GetFeeObj(module, chainID)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("cleanFee, GetFeeObj error: %v", err)
+	}
+	chainIDBytes := utils.GetUint64Bytes(chainID)
+	used := 0
+	for ; cursor <= fee.View && used < limit; cursor++ {
+		module.GetCacheDB().Delete(utils.ConcatKey(this, []byte(FEE_INFO), chainIDBytes, utils.GetUint64Bytes(cursor)))
+		used++
+	}
+	if cursor <= fee.View {
+		return cursor, used, false, nil
+	}
+	for slot := uint64(0); slot < FEE_HISTORY_SIZE; slot++ {
+		module.GetCacheDB().Delete(feeRecordKey(chainID, slot))
+	}
+	used += FEE_HISTORY_SIZE
+	if fee.View != 0 || fee.Fee.Sign() != 0 {
+		if err := PutFee(module, chainID, &Fee{View: fee.View + 1, Fee: new(big.Int)}); err != nil {
+			return 0, used, false, fmt.Errorf("cleanFee, PutFee error: %v", err)
+		}
+	}
+	return cursor, used, true, nil
+}
+
+// cleanAssets removes the registered assets, asset revisions are kept so old approvals stay stale
+func cleanAssets(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	index, err := getAssetIndex(module, chainID)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("cleanAssets, getAssetIndex error: %v", err)
+	}
+	used := 0
+	for _, asset := range index {
+		if used >= limit {
+			return cursor, used, false, nil
+		}
+		entry, err := GetAssetEntry(module, chainID, asset)
+		if err != nil {
+			return 0, used, false, fmt.Errorf("cleanAssets, GetAssetEntry error: %v", err)
+		}
+		if entry == nil {
+			entry = &Asset{ChainID: chainID, Asset: asset}
+		}
+		if err := deleteAssetEntry(module, entry); err != nil {
+			return 0, used, false, fmt.Errorf("cleanAssets, deleteAssetEntry error: %v", err)
+		}
+		used++
+	}
+	return cursor, used, true, nil
+}
+
+// cleanConfig removes the asset binds, config history and a pending update of the chain refunding its bond,
+// fee params and status are kept since their revisions guard consensus votes
+func cleanConfig(module *contract.ModuleContract, chainID, cursor uint64, limit int) (uint64, int, bool, error) {
+	module.GetCacheDB().Delete(utils.ConcatKey(this, []byte(ASSET_BIND), utils.GetUint64Bytes(chainID)))
+	module.GetCacheDB().Delete(sideChainHistoryKey(chainID))
+	if err := deleteRequest(module, UPDATE_REQUEST, chainID); err != nil {
+		return 0, 0, false, fmt.Errorf("cleanConfig, deleteRequest error: %v", err)
+	}
+	if err := refundBond(module, UPDATE_REQUEST, chainID); err != nil {
+		return 0, 0, false, fmt.Errorf("cleanConfig, refundBond error: %v", err)
+	}
+	return cursor, 3, true, nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package side_chain_manager
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// size of a compressed secp256k1 or prefixed ed25519 public key on xrpl
+	RIPPLE_PK_SIZE = 33
+	// max block confirmations an eth like chain may require
+	MAX_CONFIRMATIONS = 10000
+)
+
+// ExtraInfoSchema validates the extra info of side chains using one router
+type ExtraInfoSchema func(extraInfo []byte) error
+
+// extraInfoSchemas are declared by the routers, extra info of routers without a schema is not checked
+var extraInfoSchemas = make(map[uint64]ExtraInfoSchema)
+
+func RegisterExtraInfoSchema(router uint64, schema ExtraInfoSchema) {
+	extraInfoSchemas[router] = schema
+}
+
+func validateExtraInfo(router uint64, extraInfo []byte) error {
+	schema, ok := extraInfoSchemas[router]
+	if !ok {
+		return nil
+	}
+	if err := schema(extraInfo); err != nil {
+		return fmt.Errorf("invalid extra info for router %d: %v", router, err)
+	}
+	return nil
+}
+
+// ValidateRippleExtraInfo is the extra info schema of the ripple router
+func ValidateRippleExtraInfo(extraInfo []byte) error {
+	info := new(RippleExtraInfo)
+	if err := rlp.DecodeBytes(extraInfo, info); err != nil {
+		return fmt.Errorf("deserialize ripple extra info error: %v", err)
+	}
+	if info.Operator == common.EmptyAddress {
+		return fmt.Errorf("operator is empty")
+	}
+	if info.SignerNum == 0 || info.SignerNum != uint64(len(info.Pks)) {
+		return fmt.Errorf("signer num %d does not match %d pks", info.SignerNum, len(info.Pks))
+	}
+	if info.Quorum == 0 || info.Quorum > info.SignerNum {
+		return fmt.Errorf("quorum %d out of range, signer num %d", info.Quorum, info.SignerNum)
+	}
+	for i, pk := range info.Pks {
+		if len(pk) != RIPPLE_PK_SIZE {
+			return fmt.Errorf("invalid size of pk %d: %d", i, len(pk))
+		}
+		for _, other := range info.Pks[:i] {
+			if bytes.Equal(pk, other) {
+				return fmt.Errorf("duplicate pk %x", pk)
+			}
+		}
+	}
+	if info.ReserveAmount == nil {
+		return fmt.Errorf("reserve amount is missing")
+	}
+	return nil
+}
+
+// ValidateEthExtraInfo is the extra info schema of the eth common router, empty extra info keeps the defaults
+func ValidateEthExtraInfo(extraInfo []byte) error {
+	if len(extraInfo) == 0 {
+		return nil
+	}
+	info := new(EthExtraInfo)
+	if err := rlp.DecodeBytes(extraInfo, info); err != nil {
+		return fmt.Errorf("deserialize eth extra info error: %v", err)
+	}
+	if info.Confirmations > MAX_CONFIRMATIONS {
+		return fmt.Errorf("confirmations %d exceed %d", info.Confirmations, MAX_CONFIRMATIONS)
+	}
+	if len(info.Sealers) > 0 && !info.HeaderSync {
+		return fmt.Errorf("sealers require header sync")
+	}
+	seen := make(map[common.Address]bool, len(info.Sealers))
+	for _, sealer := range info.Sealers {
+		if sealer == common.EmptyAddress || seen[sealer] {
+			return fmt.Errorf("invalid or duplicated sealer %s", sealer.Hex())
+		}
+		seen[sealer] = true
+	}
+	return nil
+}
+
+func GetEthExtraInfo(module *contract.ModuleContract, chainId uint64) (*EthExtraInfo, error) {
+	sideChainInfo, err := GetSideChainObject(module, chainId)
+	if err != nil {
+		return nil, fmt.Errorf("GetEthExtraInfo, GetSideChainObject error: %v", err)
+	}
+	if sideChainInfo == nil {
+		return nil, fmt.Errorf("GetEthExtraInfo, side chain info is nil")
+	}
+	info := new(EthExtraInfo)
+	if len(sideChainInfo.ExtraInfo) == 0 {
+		return info, nil
+	}
+	if err := rlp.DecodeBytes(sideChainInfo.ExtraInfo, info); err != nil {
+		return nil, fmt.Errorf("GetEthExtraInfo, deserialize info error: %v", err)
+	}
+	return info, nil
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+func (this *FeeParamsParam) validate() error {
+	if this.Multiplier == 0 || this.Multiplier > MAX_FEE_MULTIPLIER {
+		return fmt.Errorf("multiplier %d out of range, max is %d", this.Multiplier, MAX_FEE_MULTIPLIER)
+	}
+	if this.Timeout == 0 {
+		return fmt.Errorf("timeout is zero")
+	}
+	if this.MinFee.Sign() < 0 || this.MaxFee.Sign() < 0 {
+		return fmt.Errorf("negative fee bound")
+	}
+	if this.MaxFee.Sign() > 0 && this.MinFee.Cmp(this.MaxFee) > 0 {
+		return fmt.Errorf("min fee %s is larger than max fee %s", this.MinFee, this.MaxFee)
+	}
+	return nil
+}
+
+// SetFeeParams changes the fee aggregation of a chain once a signer quorum votes for the same params
+func SetFeeParams(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &FeeParamsParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodSetFeeParams, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if err := params.validate(); err != nil {
+		return nil, fmt.Errorf("SetFeeParams, %v", err)
+	}
+	old, err := GetFeeParamsObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeParams, GetFeeParamsObj error: %v", err)
+	}
+	feeParams := &FeeParams{params.Multiplier, params.MinFee, params.MaxFee, params.Timeout, params.StakeWeighted, old.Revision}
+	blob, err := rlp.EncodeToBytes(feeParams)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeParams, rlp.EncodeToBytes fee params error: %v", err)
+	}
+	id := append(utils.GetUint64Bytes(params.ChainID), blob...)
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodSetFeeParams, id,
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeParams, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetFeeParams, true)
+	}
+
+	feeParams.Revision = feeParams.Revision + 1
+	if err := putFeeParams(s, params.ChainID, feeParams); err != nil {
+		return nil, fmt.Errorf("SetFeeParams, putFeeParams error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventFeeParamsUpdated}, params.ChainID, feeParams.Multiplier, feeParams.MinFee,
+		feeParams.MaxFee, feeParams.Timeout, feeParams.StakeWeighted)
+	if err != nil {
+		return nil, fmt.Errorf("SetFeeParams, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetFeeParams, true)
+}
+
+func GetFeeParams(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetFeeParams, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	feeParams, err := GetFeeParamsObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeParams, GetFeeParamsObj error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeParams, feeParams.Multiplier, feeParams.MinFee,
+		feeParams.MaxFee, feeParams.Timeout, feeParams.StakeWeighted)
+}
+
+// GetFeeVotes returns the individual fee votes of a view
+func GetFeeVotes(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &FeeViewParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetFeeInfo, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	feeInfo, err := GetFeeInfo(s, params.ChainID, params.ViewNum)
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeVotes, GetFeeInfo error: %v", err)
+	}
+	votes := sortedFeeVotes(feeInfo)
+	voters := make([]common.Address, 0, len(votes))
+	fees := make([]*big.Int, 0, len(votes))
+	for _, v := range votes {
+		voters = append(voters, v.Address)
+		fees = append(fees, v.Fee)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeInfo, feeInfo.StartHeight, voters, fees)
+}
+
+func GetFeeHistory(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetFeeHistory, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	records := make([]FeeRecord, 0, FEE_HISTORY_SIZE)
+	for slot := uint64(0); slot < FEE_HISTORY_SIZE; slot++ {
+		record, err := getFeeRecord(s, params.ChainID, slot)
+		if err != nil {
+			return nil, fmt.Errorf("GetFeeHistory, getFeeRecord error: %v", err)
+		}
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].View < records[j].View
+	})
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeHistory, records)
+}
+
+// sortedFeeVotes orders votes by fee, ties by voter address
+func sortedFeeVotes(feeInfo *FeeInfo) []*FeeVote {
+	votes := make([]*FeeVote, 0, len(feeInfo.FeeInfo))
+	for k, v := range feeInfo.FeeInfo {
+		votes = append(votes, &FeeVote{k, v})
+	}
+	sort.Slice(votes, func(i, j int) bool {
+		if c := votes[i].Fee.Cmp(votes[j].Fee); c != 0 {
+			return c < 0
+		}
+		return votes[i].Address.Hash().Big().Cmp(votes[j].Address.Hash().Big()) < 0
+	})
+	return votes
+}
+
+// aggregateFee applies the multiplier to the median of votes and clamps the result to the fee bounds
+func aggregateFee(module *contract.ModuleContract, feeParams *FeeParams, feeInfo *FeeInfo) (*big.Int, error) {
+	votes := sortedFeeVotes(feeInfo)
+	if len(votes) == 0 {
+		return nil, fmt.Errorf("no fee votes")
+	}
+	// the median is sum / count, so the multiplier applies before the division as before
+	sum, count := new(big.Int), int64(1)
+	l := len(votes)
+	if l%2 == 0 {
+		sum.Add(votes[l/2].Fee, votes[l/2-1].Fee)
+		count = 2
+	} else {
+		sum.Set(votes[(l-1)/2].Fee)
+	}
+	if feeParams.StakeWeighted {
+		median, err := stakeWeightedMedian(module, votes)
+		if err != nil {
+			return nil, err
+		}
+		if median != nil {
+			sum, count = median, 1
+		}
+	}
+	fee := new(big.Int).Mul(sum, new(big.Int).SetUint64(feeParams.Multiplier))
+	fee.Div(fee, big.NewInt(100*count))
+	if feeParams.MinFee.Sign() > 0 && fee.Cmp(feeParams.MinFee) < 0 {
+		fee.Set(feeParams.MinFee)
+	}
+	if feeParams.MaxFee.Sign() > 0 && fee.Cmp(feeParams.MaxFee) > 0 {
+		fee.Set(feeParams.MaxFee)
+	}
+	return fee, nil
+}
+
+// stakeWeightedMedian returns the lowest fee backed by at least half of the stake of all votes,
+// or nil if the voters have no stake
+func stakeWeightedMedian(module *contract.ModuleContract, votes []*FeeVote) (*big.Int, error) {
+	stakes, err := node_manager.GetSignerStakes(module)
+	if err != nil {
+		return nil, fmt.Errorf("stakeWeightedMedian, node_manager.GetSignerStakes error: %v", err)
+	}
+	total := new(big.Int)
+	for _, v := range votes {
+		if stake, ok := stakes[v.Address]; ok {
+			total.Add(total, stake)
+		}
+	}
+	if total.Sign() == 0 {
+		return nil, nil
+	}
+	acc := new(big.Int)
+	for _, v := range votes {
+		if stake, ok := stakes[v.Address]; ok {
+			acc.Add(acc, stake)
+		}
+		if new(big.Int).Mul(acc, big.NewInt(2)).Cmp(total) >= 0 {
+			return v.Fee, nil
+		}
+	}
+	return votes[len(votes)-1].Fee, nil
+}
+
+func feeParamsKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(FEE_PARAMS), utils.GetUint64Bytes(chainID))
+}
+
+// GetFeeParamsObj returns the fee params of a chain, chains without params keep the original aggregation
+func GetFeeParamsObj(module *contract.ModuleContract, chainID uint64) (*FeeParams, error) {
+	store, err := module.GetCacheDB().Get(feeParamsKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetFeeParamsObj, get fee params store error: %v", err)
+	}
+	feeParams := &FeeParams{
+		Multiplier: DEFAULT_FEE_MULTIPLIER,
+		MinFee:     new(big.Int),
+		MaxFee:     new(big.Int),
+		Timeout:    UPDATE_FEE_TIMEOUT,
+	}
+	if store != nil {
+		if err := rlp.DecodeBytes(store, feeParams); err != nil {
+			return nil, fmt.Errorf("GetFeeParamsObj, deserialize fee params error: %v", err)
+		}
+	}
+	return feeParams, nil
+}
+
+func putFeeParams(module *contract.ModuleContract, chainID uint64, feeParams *FeeParams) error {
+	blob, err := rlp.EncodeToBytes(feeParams)
+	if err != nil {
+		return fmt.Errorf("putFeeParams, rlp.EncodeToBytes fee params error: %v", err)
+	}
+	return module.GetCacheDB().Put(feeParamsKey(chainID), blob)
+}
+
+func feeRecordKey(chainID, slot uint64) []byte {
+	return utils.ConcatKey(this, []byte(FEE_HISTORY), utils.GetUint64Bytes(chainID), utils.GetUint64Bytes(slot))
+}
+
+// putFeeRecord keeps the last FEE_HISTORY_SIZE aggregated fees of a chain, indexed by view
+func putFeeRecord(module *contract.ModuleContract, chainID uint64, record *FeeRecord) error {
+	blob, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return fmt.Errorf("putFeeRecord, rlp.EncodeToBytes fee record error: %v", err)
+	}
+	return module.GetCacheDB().Put(feeRecordKey(chainID, record.View%FEE_HISTORY_SIZE), blob)
+}
+
+func getFeeRecord(module *contract.ModuleContract, chainID, slot uint64) (*FeeRecord, error) {
+	store, err := module.GetCacheDB().Get(feeRecordKey(chainID, slot))
+	if err != nil {
+		return nil, fmt.Errorf("getFeeRecord, get fee record store error: %v", err)
+	}
+	if store == nil {
+		return nil, nil
+	}
+	record := new(FeeRecord)
+	if err := rlp.DecodeBytes(store, record); err != nil {
+		return nil, fmt.Errorf("getFeeRecord, deserialize fee record error: %v", err)
+	}
+	return record, nil
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
+)
+
+// SyncedHeight returns the highest synced height of a side chain
+type SyncedHeight func(module *contract.ModuleContract, chainID uint64) (uint64, error)
+
+// syncedHeight is provided by info_sync, side chain updates have to activate above the synced height
+var syncedHeight SyncedHeight
+
+func RegisterSyncedHeight(f SyncedHeight) {
+	syncedHeight = f
+}
+
+// SideChainVersion is a side chain config used for heights from ActivationHeight up to the next version
+type SideChainVersion struct {
+	ActivationHeight uint64
+	SideChain        *SideChain
+}
+
+func GetSideChainAtHeight(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &HeightParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetSideChainAtHeight, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := GetSideChainObjectAtHeight(s, params.ChainID, params.Height)
+	if err != nil {
+		return nil, fmt.Errorf("GetSideChainAtHeight, GetSideChainObjectAtHeight error: %v", err)
+	}
+	if sideChain == nil {
+		sideChain = &SideChain{}
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChainAtHeight, sideChain)
+}
+
+// GetSideChainObjectAtHeight returns the config of a side chain active at a side chain height,
+// the latest version is the live record which also carries later owner and extra info changes
+func GetSideChainObjectAtHeight(module *contract.ModuleContract, chainID, height uint64) (*SideChain, error) {
+	history, err := getSideChainHistory(module, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetSideChainObjectAtHeight, getSideChainHistory error: %v", err)
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ActivationHeight > height {
+			continue
+		}
+		if i < len(history)-1 {
+			return history[i].SideChain, nil
+		}
+		break
+	}
+	return GetSideChainObject(module, chainID)
+}
+
+// addSideChainVersion records a new config of a side chain used from the activation height, which is above
+// the synced height, so later versions that were never used are dropped
+func addSideChainVersion(module *contract.ModuleContract, previous, sideChain *SideChain, activation uint64) error {
+	// a newly registered chain starts a new history
+	if previous == nil {
+		return putSideChainHistory(module, sideChain.ChainID, []*SideChainVersion{{0, sideChain}})
+	}
+	history, err := getSideChainHistory(module, sideChain.ChainID)
+	if err != nil {
+		return err
+	}
+	// chains registered before versioning keep their original config for the heights synced so far
+	if len(history) == 0 {
+		history = append(history, &SideChainVersion{0, previous})
+	}
+	for len(history) > 1 && history[len(history)-1].ActivationHeight >= activation {
+		history = history[:len(history)-1]
+	}
+	history = append(history, &SideChainVersion{activation, sideChain})
+	return putSideChainHistory(module, sideChain.ChainID, history)
+}
+
+// minActivationHeight returns the height above the synced height of a side chain, the lowest one an update can activate at
+func minActivationHeight(module *contract.ModuleContract, chainID uint64) (uint64, error) {
+	if syncedHeight == nil {
+		return 1, nil
+	}
+	height, err := syncedHeight(module, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("minActivationHeight, syncedHeight error: %v", err)
+	}
+	return height + 1, nil
+}
+
+func activationHeightKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(UPDATE_ACTIVATION_HEIGHT), utils.GetUint64Bytes(chainID))
+}
+
+// getActivationHeight returns the activation height of the pending update request of a side chain,
+// 0 for requests made before it was requested
+func getActivationHeight(module *contract.ModuleContract, chainID uint64) (uint64, error) {
+	store, err := module.GetCacheDB().Get(activationHeightKey(chainID))
+	if err != nil {
+		return 0, fmt.Errorf("getActivationHeight, get activation height store error: %v", err)
+	}
+	if store == nil {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(store), nil
+}
+
+func putActivationHeight(module *contract.ModuleContract, chainID, activation uint64) error {
+	return module.GetCacheDB().Put(activationHeightKey(chainID), utils.GetUint64Bytes(activation))
+}
+
+func sideChainHistoryKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SIDE_CHAIN_HISTORY), utils.GetUint64Bytes(chainID))
+}
+
+func getSideChainHistory(module *contract.ModuleContract, chainID uint64) ([]*SideChainVersion, error) {
+	store, err := module.GetCacheDB().Get(sideChainHistoryKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("getSideChainHistory, get side chain history store error: %v", err)
+	}
+	history := make([]*SideChainVersion, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &history); err != nil {
+			return nil, fmt.Errorf("getSideChainHistory, deserialize side chain history error: %v", err)
+		}
+	}
+	return history, nil
+}
+
+func putSideChainHistory(module *contract.ModuleContract, chainID uint64, history []*SideChainVersion) error {
+	blob, err := rlp.EncodeToBytes(history)
+	if err != nil {
+		return fmt.Errorf("putSideChainHistory, rlp.EncodeToBytes side chain history error: %v", err)
+	}
+	return module.GetCacheDB().Put(sideChainHistoryKey(chainID), blob)
+}
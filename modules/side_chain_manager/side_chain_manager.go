@@ -22,7 +22,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -44,8 +43,43 @@ const (
 	FEE                       = "fee"
 	FEE_INFO                  = "feeInfo"
 	ASSET_BIND                = "assetBind"
+	SIDE_CHAIN_INDEX          = "sideChainIndex"
+	PENDING_REQUEST_INDEX     = "pendingRequestIndex"
+	REQUEST_EXPIRY            = "requestExpiry"
+	PENDING_OWNER             = "pendingOwner"
+	ASSET                     = "asset"
+	ASSET_INDEX               = "assetIndex"
+	ASSET_SYMBOL              = "assetSymbol"
+	ASSET_REVISION            = "assetRevision"
+	FEE_PARAMS                = "feeParams"
+	FEE_HISTORY               = "feeHistory"
+	SIDE_CHAIN_HISTORY        = "sideChainHistory"
+	SIDE_CHAIN_STATUS         = "sideChainStatus"
+	CLEANUP_QUEUE             = "cleanupQueue"
+	CLEANUP_TASK              = "cleanupTask"
+	BOND_PARAMS               = "bondParams"
+	REQUEST_BOND              = "requestBond"
+	UPDATE_ACTIVATION_HEIGHT  = "updateActivationHeight"
 
 	UPDATE_FEE_TIMEOUT = 100
+	// percent applied to the median fee of chains without fee params
+	DEFAULT_FEE_MULTIPLIER = 500
+	MAX_FEE_MULTIPLIER     = 100000
+	// aggregated fees kept per chain
+	FEE_HISTORY_SIZE = 32
+	// max side chains returned by one paged query
+	MAX_PAGE_SIZE = 100
+	// blocks a register, update or quit request stays pending before it is dropped
+	REQUEST_EXPIRY_PERIOD = 100000
+	// state entries of quit chains deleted per block
+	CLEANUP_BATCH_SIZE = 256
+)
+
+// request types of pending side chain requests
+const (
+	REGISTER_REQUEST uint8 = iota
+	UPDATE_REQUEST
+	QUIT_REQUEST
 )
 
 var (
@@ -56,6 +90,11 @@ var (
 func InitSideChainManager() {
 	ABI = GetABI()
 	contract.Contracts.RegisterContract(this, RegisterSideChainManagerContract)
+
+	RegisterChainCleaner("side_chain_manager.fee", cleanFee)
+	RegisterChainCleaner("side_chain_manager.asset", cleanAssets)
+	RegisterChainCleaner("side_chain_manager.config", cleanConfig)
+	node_manager.RegisterEndBlockHook("side_chain_manager.cleanup", ProcessCleanup)
 }
 
 func RegisterSideChainManagerContract(s *contract.ModuleContract) {
@@ -63,15 +102,40 @@ func RegisterSideChainManagerContract(s *contract.ModuleContract) {
 
 	// s.Register(MethodContractName, Name)
 	s.Register(side_chain_manager_abi.MethodGetSideChain, GetSideChain)
+	s.Register(side_chain_manager_abi.MethodGetSideChainAtHeight, GetSideChainAtHeight)
+	s.Register(side_chain_manager_abi.MethodGetAllSideChains, GetAllSideChains)
+	s.Register(side_chain_manager_abi.MethodGetSideChainCount, GetSideChainCount)
 	s.Register(side_chain_manager_abi.MethodRegisterSideChain, RegisterSideChain)
 	s.Register(side_chain_manager_abi.MethodApproveRegisterSideChain, ApproveRegisterSideChain)
 	s.Register(side_chain_manager_abi.MethodUpdateSideChain, UpdateSideChain)
 	s.Register(side_chain_manager_abi.MethodApproveUpdateSideChain, ApproveUpdateSideChain)
 	s.Register(side_chain_manager_abi.MethodQuitSideChain, QuitSideChain)
 	s.Register(side_chain_manager_abi.MethodApproveQuitSideChain, ApproveQuitSideChain)
+	s.Register(side_chain_manager_abi.MethodSetSideChainStatus, SetSideChainStatus)
+	s.Register(side_chain_manager_abi.MethodGetSideChainStatus, GetSideChainStatus)
+	s.Register(side_chain_manager_abi.MethodGetPendingRequest, GetPendingRequest)
+	s.Register(side_chain_manager_abi.MethodGetPendingRequests, GetPendingRequests)
+	s.Register(side_chain_manager_abi.MethodCancelRequest, CancelRequest)
+	s.Register(side_chain_manager_abi.MethodRejectRequest, RejectRequest)
+	s.Register(side_chain_manager_abi.MethodSetBondParams, SetBondParams)
+	s.Register(side_chain_manager_abi.MethodGetBondParams, GetBondParams)
+	s.Register(side_chain_manager_abi.MethodGetRequestBond, GetRequestBond)
+	s.Register(side_chain_manager_abi.MethodTransferOwnership, TransferOwnership)
+	s.Register(side_chain_manager_abi.MethodAcceptOwnership, AcceptOwnership)
+	s.Register(side_chain_manager_abi.MethodRecoverOwnership, RecoverOwnership)
+	s.Register(side_chain_manager_abi.MethodGetPendingOwner, GetPendingOwner)
 	s.Register(side_chain_manager_abi.MethodRegisterAsset, RegisterAsset)
+	s.Register(side_chain_manager_abi.MethodApproveAsset, ApproveAsset)
+	s.Register(side_chain_manager_abi.MethodRemoveAsset, RemoveAsset)
+	s.Register(side_chain_manager_abi.MethodGetAsset, GetAsset)
+	s.Register(side_chain_manager_abi.MethodGetAssets, GetAssets)
+	s.Register(side_chain_manager_abi.MethodGetAssetCount, GetAssetCount)
 	s.Register(side_chain_manager_abi.MethodUpdateFee, UpdateFee)
 	s.Register(side_chain_manager_abi.MethodGetFee, GetFee)
+	s.Register(side_chain_manager_abi.MethodSetFeeParams, SetFeeParams)
+	s.Register(side_chain_manager_abi.MethodGetFeeParams, GetFeeParams)
+	s.Register(side_chain_manager_abi.MethodGetFeeInfo, GetFeeVotes)
+	s.Register(side_chain_manager_abi.MethodGetFeeHistory, GetFeeHistory)
 }
 
 func GetSideChain(s *contract.ModuleContract) ([]byte, error) {
@@ -90,6 +154,41 @@ func GetSideChain(s *contract.ModuleContract) ([]byte, error) {
 	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChain, sideChain)
 }
 
+func GetAllSideChains(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &PageParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetAllSideChains, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if params.Limit == 0 || params.Limit > MAX_PAGE_SIZE {
+		return nil, fmt.Errorf("GetAllSideChains, invalid limit, min 1, max %d, current %d", MAX_PAGE_SIZE, params.Limit)
+	}
+	index, err := getChainIndex(s, sideChainIndexKey())
+	if err != nil {
+		return nil, fmt.Errorf("GetAllSideChains, getChainIndex error: %v", err)
+	}
+	sideChains := make([]SideChain, 0, params.Limit)
+	for i := params.Offset; i < uint64(len(index)) && uint64(len(sideChains)) < params.Limit; i++ {
+		sideChain, err := GetSideChainObject(s, index[i])
+		if err != nil {
+			return nil, fmt.Errorf("GetAllSideChains, GetSideChainObject error: %v", err)
+		}
+		if sideChain == nil {
+			return nil, fmt.Errorf("GetAllSideChains, indexed side chain %d not exist", index[i])
+		}
+		sideChains = append(sideChains, *sideChain)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAllSideChains, sideChains)
+}
+
+func GetSideChainCount(s *contract.ModuleContract) ([]byte, error) {
+	index, err := getChainIndex(s, sideChainIndexKey())
+	if err != nil {
+		return nil, fmt.Errorf("GetSideChainCount, getChainIndex error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChainCount, uint64(len(index)))
+}
+
 func RegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &RegisterSideChainParam{}
@@ -109,12 +208,22 @@ func RegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return nil, errors.New("param extra info too long, max is 1000")
 	}
 
+	if err := validateExtraInfo(params.Router, params.ExtraInfo); err != nil {
+		return nil, fmt.Errorf("RegisterSideChain, %v", err)
+	}
+
 	registerSideChain, err := GetSideChainApply(s, params.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSideChain, getRegisterSideChain error: %v", err)
 	}
 	if registerSideChain != nil {
-		return nil, fmt.Errorf("RegisterSideChain, chainid already requested")
+		expired, err := isRequestExpired(s, REGISTER_REQUEST, params.ChainID)
+		if err != nil {
+			return nil, fmt.Errorf("RegisterSideChain, isRequestExpired error: %v", err)
+		}
+		if !expired {
+			return nil, fmt.Errorf("RegisterSideChain, chainid already requested")
+		}
 	}
 	sideChain, err := GetSideChainObject(s, params.ChainID)
 	if err != nil {
@@ -123,6 +232,14 @@ func RegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if sideChain != nil {
 		return nil, fmt.Errorf("RegisterSideChain, chainid already registered")
 	}
+	if cleaning, err := isCleanupPending(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("RegisterSideChain, isCleanupPending error: %v", err)
+	} else if cleaning {
+		return nil, fmt.Errorf("RegisterSideChain, state of the quit chain is still being cleaned up")
+	}
+	if err := collectBond(s, REGISTER_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("RegisterSideChain, collectBond error: %v", err)
+	}
 
 	sideChain = &SideChain{
 		Owner:       s.ContractRef().TxOrigin(),
@@ -136,6 +253,9 @@ func RegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSideChain, putRegisterSideChain error: %v", err)
 	}
+	if err := putRequestExpiry(s, REGISTER_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("RegisterSideChain, putRequestExpiry error: %v", err)
+	}
 
 	err = s.AddNotify(ABI, []string{EventRegisterSideChain}, params.ChainID, params.Router, params.Name)
 	if err != nil {
@@ -159,7 +279,17 @@ func ApproveRegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return nil, fmt.Errorf("ApproveRegisterSideChain, chainid is not requested")
 	}
 
-	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveRegisterSideChain, utils.GetUint64Bytes(params.ChainID),
+	if expired, err := dropExpiredRequest(s, REGISTER_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, dropExpiredRequest error: %v", err)
+	} else if expired {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, false)
+	}
+
+	id, err := requestVoteID(s, REGISTER_REQUEST, registerSideChain)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, requestVoteID error: %v", err)
+	}
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveRegisterSideChain, id,
 		s.ContractRef().TxOrigin(), node_manager.Signer)
 	if err != nil {
 		return nil, fmt.Errorf("ApproveRegisterSideChain, CheckConsensusSigns error: %v", err)
@@ -172,8 +302,20 @@ func ApproveRegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ApproveRegisterSideChain, putSideChain error: %v", err)
 	}
+	if err := addChainIndex(s, sideChainIndexKey(), params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, addChainIndex error: %v", err)
+	}
+	if err := addSideChainVersion(s, nil, registerSideChain, 0); err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, addSideChainVersion error: %v", err)
+	}
 
 	s.GetCacheDB().Delete(utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(SIDE_CHAIN_APPLY), utils.GetUint64Bytes(params.ChainID)))
+	if err := clearRequestExpiry(s, REGISTER_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, clearRequestExpiry error: %v", err)
+	}
+	if err := refundBond(s, REGISTER_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveRegisterSideChain, refundBond error: %v", err)
+	}
 	err = s.AddNotify(ABI, []string{EventApproveRegisterSideChain}, params.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("ApproveRegisterSideChain, AddNotify error: %v", err)
@@ -183,7 +325,7 @@ func ApproveRegisterSideChain(s *contract.ModuleContract) ([]byte, error) {
 
 func UpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
-	params := &RegisterSideChainParam{}
+	params := &UpdateSideChainParam{}
 	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodUpdateSideChain, params, ctx.Payload); err != nil {
 		return nil, err
 	}
@@ -200,6 +342,10 @@ func UpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return nil, errors.New("param extra info too long, max is 1000")
 	}
 
+	if err := validateExtraInfo(params.Router, params.ExtraInfo); err != nil {
+		return nil, fmt.Errorf("UpdateSideChain, %v", err)
+	}
+
 	sideChain, err := GetSideChainObject(s, params.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSideChain, getSideChain error: %v", err)
@@ -210,6 +356,16 @@ func UpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if sideChain.Owner != s.ContractRef().TxOrigin() {
 		return nil, fmt.Errorf("UpdateSideChain, side chain owner is wrong")
 	}
+	activation, err := minActivationHeight(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSideChain, minActivationHeight error: %v", err)
+	}
+	if params.ActivationHeight < activation {
+		return nil, fmt.Errorf("UpdateSideChain, activation height %d is below %d", params.ActivationHeight, activation)
+	}
+	if err := collectBond(s, UPDATE_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("UpdateSideChain, collectBond error: %v", err)
+	}
 	updateSideChain := &SideChain{
 		Owner:       s.ContractRef().TxOrigin(),
 		ChainID:     params.ChainID,
@@ -222,6 +378,12 @@ func UpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSideChain, putUpdateSideChain error: %v", err)
 	}
+	if err := putActivationHeight(s, params.ChainID, params.ActivationHeight); err != nil {
+		return nil, fmt.Errorf("UpdateSideChain, putActivationHeight error: %v", err)
+	}
+	if err := putRequestExpiry(s, UPDATE_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("UpdateSideChain, putRequestExpiry error: %v", err)
+	}
 	err = s.AddNotify(ABI, []string{EventUpdateSideChain}, params.ChainID, params.Router, params.Name)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSideChain, AddNotify error: %v", err)
@@ -245,8 +407,24 @@ func ApproveUpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return nil, fmt.Errorf("ApproveUpdateSideChain, chainid is not requested update")
 	}
 
+	if expired, err := dropExpiredRequest(s, UPDATE_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, dropExpiredRequest error: %v", err)
+	} else if expired {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveUpdateSideChain, false)
+	}
+
+	requested, err := getActivationHeight(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, getActivationHeight error: %v", err)
+	}
+	id, err := requestVoteID(s, UPDATE_REQUEST, sideChain)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, requestVoteID error: %v", err)
+	}
+	id = append(id, utils.GetUint64Bytes(requested)...)
+
 	//check consensus signs
-	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveUpdateSideChain, utils.GetUint64Bytes(params.ChainID),
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveUpdateSideChain, id,
 		s.ContractRef().TxOrigin(), node_manager.Signer)
 	if err != nil {
 		return nil, fmt.Errorf("ApproveUpdateSideChain, CheckConsensusSigns error: %v", err)
@@ -255,13 +433,36 @@ func ApproveUpdateSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveUpdateSideChain, true)
 	}
 
+	// heights synced since the request was made keep the config they were synced with,
+	// requests made before the activation height was requested activate above the synced height
+	activation, err := minActivationHeight(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, minActivationHeight error: %v", err)
+	}
+	if requested != 0 {
+		if requested < activation {
+			return nil, fmt.Errorf("ApproveUpdateSideChain, activation height %d is synced already", requested)
+		}
+		activation = requested
+	}
+	previous, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, GetSideChainObject error: %v", err)
+	}
+	if err := addSideChainVersion(s, previous, sideChain, activation); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, addSideChainVersion error: %v", err)
+	}
 	err = PutSideChain(s, sideChain)
 	if err != nil {
 		return nil, fmt.Errorf("ApproveUpdateSideChain, putSideChain error: %v", err)
 	}
 
-	chainidByte := utils.GetUint64Bytes(params.ChainID)
-	s.GetCacheDB().Delete(utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(UPDATE_SIDE_CHAIN_REQUEST), chainidByte))
+	if err := deleteRequest(s, UPDATE_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, deleteRequest error: %v", err)
+	}
+	if err := refundBond(s, UPDATE_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveUpdateSideChain, refundBond error: %v", err)
+	}
 
 	err = s.AddNotify(ABI, []string{EventApproveUpdateSideChain}, params.ChainID)
 	if err != nil {
@@ -292,6 +493,9 @@ func QuitSideChain(s *contract.ModuleContract) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("QuitSideChain, putUpdateSideChain error: %v", err)
 	}
+	if err := putRequestExpiry(s, QUIT_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("QuitSideChain, putRequestExpiry error: %v", err)
+	}
 
 	err = s.AddNotify(ABI, []string{EventQuitSideChain}, params.ChainID)
 	if err != nil {
@@ -312,8 +516,35 @@ func ApproveQuitSideChain(s *contract.ModuleContract) ([]byte, error) {
 		return nil, fmt.Errorf("ApproveQuitSideChain, getQuitSideChain error: %v", err)
 	}
 
+	if expired, err := dropExpiredRequest(s, QUIT_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, dropExpiredRequest error: %v", err)
+	} else if expired {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveQuitSideChain, false)
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, chainid is not registered")
+	}
+	// transfers to the chain must be stopped and the ones in flight settled before its state is purged
+	if status, err := GetSideChainStatusObj(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, GetSideChainStatusObj error: %v", err)
+	} else if status.Status != STATUS_DRAINING && status.Status != STATUS_DEPRECATED {
+		return nil, fmt.Errorf("ApproveQuitSideChain, side chain %d is not draining or deprecated", params.ChainID)
+	}
+	if err := checkDrained(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, %v", err)
+	}
+	id, err := requestVoteID(s, QUIT_REQUEST, sideChain)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, requestVoteID error: %v", err)
+	}
+
 	//check consensus signs
-	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveQuitSideChain, utils.GetUint64Bytes(params.ChainID),
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodApproveQuitSideChain, id,
 		s.ContractRef().TxOrigin(), node_manager.Signer)
 	if err != nil {
 		return nil, fmt.Errorf("ApproveQuitSideChain, CheckConsensusSigns error: %v", err)
@@ -323,8 +554,27 @@ func ApproveQuitSideChain(s *contract.ModuleContract) ([]byte, error) {
 	}
 
 	chainidByte := utils.GetUint64Bytes(params.ChainID)
-	s.GetCacheDB().Delete(utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(side_chain_manager_abi.MethodApproveQuitSideChain), chainidByte))
 	s.GetCacheDB().Delete(utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(SIDE_CHAIN), chainidByte))
+	if err := removeChainIndex(s, sideChainIndexKey(), params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, removeChainIndex error: %v", err)
+	}
+	if err := deleteRequest(s, QUIT_REQUEST, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, deleteRequest error: %v", err)
+	}
+	deletePendingOwner(s, params.ChainID)
+	// per chain state of all modules is purged by the end block cleanup in bounded batches
+	if err := enqueueCleanup(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, enqueueCleanup error: %v", err)
+	}
+	// a chain registered again starts active, the revision is kept so old status votes stay stale
+	if status, err := GetSideChainStatusObj(s, params.ChainID); err != nil {
+		return nil, fmt.Errorf("ApproveQuitSideChain, GetSideChainStatusObj error: %v", err)
+	} else if status.Status != STATUS_ACTIVE {
+		status.Status, status.Revision = STATUS_ACTIVE, status.Revision+1
+		if err := putSideChainStatus(s, params.ChainID, status); err != nil {
+			return nil, fmt.Errorf("ApproveQuitSideChain, putSideChainStatus error: %v", err)
+		}
+	}
 
 	err = s.AddNotify(ABI, []string{EventApproveQuitSideChain}, params.ChainID)
 	if err != nil {
@@ -333,6 +583,332 @@ func ApproveQuitSideChain(s *contract.ModuleContract) ([]byte, error) {
 	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveQuitSideChain, true)
 }
 
+// requestVoteID is the consensus sign input of approving a request. The side chain and expiry tell later
+// requests of the same chain apart, so a chain can be updated, quit and registered again more than once
+func requestVoteID(s *contract.ModuleContract, requestType uint8, sideChain *SideChain) ([]byte, error) {
+	expiry, err := getRequestExpiry(s, requestType, sideChain.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	id := utils.GetUint64Bytes(sideChain.ChainID)
+	if expiry != 0 {
+		blob, err := rlp.EncodeToBytes(sideChain)
+		if err != nil {
+			return nil, fmt.Errorf("rlp.EncodeToBytes side chain error: %v", err)
+		}
+		id = append(id, crypto.Keccak256(blob)...)
+		id = append(id, utils.GetUint64Bytes(expiry)...)
+	}
+	return id, nil
+}
+
+// dropExpiredRequest deletes a request whose expiry height has passed
+func dropExpiredRequest(s *contract.ModuleContract, requestType uint8, chainID uint64) (bool, error) {
+	expired, err := isRequestExpired(s, requestType, chainID)
+	if err != nil || !expired {
+		return false, err
+	}
+	if err := deleteRequest(s, requestType, chainID); err != nil {
+		return false, err
+	}
+	if err := refundBond(s, requestType, chainID); err != nil {
+		return false, err
+	}
+	if err := s.AddNotify(ABI, []string{EventRequestExpired}, chainID, requestType); err != nil {
+		return false, fmt.Errorf("AddNotify error: %v", err)
+	}
+	return true, nil
+}
+
+func GetPendingRequest(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RequestParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetPendingRequest, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := getPendingRequest(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingRequest, getPendingRequest error: %v", err)
+	}
+	expired, err := isRequestExpired(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingRequest, isRequestExpired error: %v", err)
+	}
+	if sideChain == nil || expired {
+		return nil, fmt.Errorf("GetPendingRequest, no pending request of type %d for chain %d", params.RequestType, params.ChainID)
+	}
+	expiry, err := getRequestExpiry(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingRequest, getRequestExpiry error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetPendingRequest, sideChain, expiry)
+}
+
+func GetPendingRequests(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RequestPageParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetPendingRequests, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if params.Limit == 0 || params.Limit > MAX_PAGE_SIZE {
+		return nil, fmt.Errorf("GetPendingRequests, invalid limit, min 1, max %d, current %d", MAX_PAGE_SIZE, params.Limit)
+	}
+	if _, err := requestKey(params.RequestType, 0); err != nil {
+		return nil, fmt.Errorf("GetPendingRequests, %v", err)
+	}
+	index, err := getChainIndex(s, requestIndexKey(params.RequestType))
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingRequests, getChainIndex error: %v", err)
+	}
+	// expired requests are skipped, the offset counts live requests only
+	sideChains := make([]SideChain, 0, params.Limit)
+	skipped := uint64(0)
+	for _, chainID := range index {
+		if uint64(len(sideChains)) >= params.Limit {
+			break
+		}
+		expired, err := isRequestExpired(s, params.RequestType, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingRequests, isRequestExpired error: %v", err)
+		}
+		if expired {
+			continue
+		}
+		if skipped < params.Offset {
+			skipped++
+			continue
+		}
+		sideChain, err := getPendingRequest(s, params.RequestType, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingRequests, getPendingRequest error: %v", err)
+		}
+		if sideChain != nil {
+			sideChains = append(sideChains, *sideChain)
+		}
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetPendingRequests, sideChains)
+}
+
+// CancelRequest lets the owner withdraw a pending register, update or quit request
+func CancelRequest(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RequestParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodCancelRequest, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := getPendingRequest(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("CancelRequest, getPendingRequest error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("CancelRequest, no pending request of type %d for chain %d", params.RequestType, params.ChainID)
+	}
+	if sideChain.Owner != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("CancelRequest, side chain owner is wrong")
+	}
+	if err := deleteRequest(s, params.RequestType, params.ChainID); err != nil {
+		return nil, fmt.Errorf("CancelRequest, deleteRequest error: %v", err)
+	}
+	if err := refundBond(s, params.RequestType, params.ChainID); err != nil {
+		return nil, fmt.Errorf("CancelRequest, refundBond error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventCancelRequest}, params.ChainID, params.RequestType)
+	if err != nil {
+		return nil, fmt.Errorf("CancelRequest, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodCancelRequest, true)
+}
+
+// RejectRequest drops a pending request once a quorum of signers rejected it
+func RejectRequest(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &RequestParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodRejectRequest, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	sideChain, err := getPendingRequest(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RejectRequest, getPendingRequest error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("RejectRequest, no pending request of type %d for chain %d", params.RequestType, params.ChainID)
+	}
+
+	// the expiry tells a later request of the same chain apart from this one
+	expiry, err := getRequestExpiry(s, params.RequestType, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RejectRequest, getRequestExpiry error: %v", err)
+	}
+	id := append([]byte{params.RequestType}, utils.GetUint64Bytes(params.ChainID)...)
+	id = append(id, utils.GetUint64Bytes(expiry)...)
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodRejectRequest, id,
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("RejectRequest, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRejectRequest, true)
+	}
+
+	if err := deleteRequest(s, params.RequestType, params.ChainID); err != nil {
+		return nil, fmt.Errorf("RejectRequest, deleteRequest error: %v", err)
+	}
+	if err := forfeitBond(s, params.RequestType, params.ChainID); err != nil {
+		return nil, fmt.Errorf("RejectRequest, forfeitBond error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventRejectRequest}, params.ChainID, params.RequestType)
+	if err != nil {
+		return nil, fmt.Errorf("RejectRequest, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRejectRequest, true)
+}
+
+// TransferOwnership proposes a new owner of a side chain, the transfer completes once the new owner accepts
+func TransferOwnership(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &OwnershipParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodTransferOwnership, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("TransferOwnership, getSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("TransferOwnership, side chain is not registered")
+	}
+	if sideChain.Owner != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("TransferOwnership, side chain owner is wrong")
+	}
+	if params.NewOwner == common.EmptyAddress || params.NewOwner == sideChain.Owner {
+		return nil, fmt.Errorf("TransferOwnership, invalid new owner %s", params.NewOwner.Hex())
+	}
+	if err := putPendingOwner(s, params.ChainID, params.NewOwner); err != nil {
+		return nil, fmt.Errorf("TransferOwnership, putPendingOwner error: %v", err)
+	}
+
+	err = s.AddNotify(ABI, []string{EventOwnershipTransferStarted}, params.ChainID, sideChain.Owner, params.NewOwner)
+	if err != nil {
+		return nil, fmt.Errorf("TransferOwnership, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodTransferOwnership, true)
+}
+
+func AcceptOwnership(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodAcceptOwnership, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("AcceptOwnership, getSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("AcceptOwnership, side chain is not registered")
+	}
+	pendingOwner, err := getPendingOwner(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("AcceptOwnership, getPendingOwner error: %v", err)
+	}
+	if pendingOwner == common.EmptyAddress || pendingOwner != s.ContractRef().TxOrigin() {
+		return nil, fmt.Errorf("AcceptOwnership, caller is not the pending owner")
+	}
+
+	previousOwner := sideChain.Owner
+	if err := setOwner(s, sideChain, pendingOwner); err != nil {
+		return nil, fmt.Errorf("AcceptOwnership, setOwner error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventOwnershipTransferred}, params.ChainID, previousOwner, pendingOwner)
+	if err != nil {
+		return nil, fmt.Errorf("AcceptOwnership, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodAcceptOwnership, true)
+}
+
+// RecoverOwnership hands a side chain whose owner key is lost to a new owner once a quorum of signers agreed
+func RecoverOwnership(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &OwnershipParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodRecoverOwnership, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("RecoverOwnership, getSideChain error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("RecoverOwnership, side chain is not registered")
+	}
+	if params.NewOwner == common.EmptyAddress || params.NewOwner == sideChain.Owner {
+		return nil, fmt.Errorf("RecoverOwnership, invalid new owner %s", params.NewOwner.Hex())
+	}
+
+	// the current owner is part of the input, so the same recovery can be voted again later
+	id := append(utils.GetUint64Bytes(params.ChainID), sideChain.Owner.Bytes()...)
+	id = append(id, params.NewOwner.Bytes()...)
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodRecoverOwnership, id,
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("RecoverOwnership, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRecoverOwnership, true)
+	}
+
+	previousOwner := sideChain.Owner
+	if err := setOwner(s, sideChain, params.NewOwner); err != nil {
+		return nil, fmt.Errorf("RecoverOwnership, setOwner error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventOwnershipRecovered}, params.ChainID, previousOwner, params.NewOwner)
+	if err != nil {
+		return nil, fmt.Errorf("RecoverOwnership, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodRecoverOwnership, true)
+}
+
+func GetPendingOwner(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetPendingOwner, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	pendingOwner, err := getPendingOwner(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingOwner, getPendingOwner error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetPendingOwner, pendingOwner)
+}
+
+// setOwner moves a side chain and its pending update request to a new owner
+func setOwner(s *contract.ModuleContract, sideChain *SideChain, owner common.Address) error {
+	sideChain.Owner = owner
+	if err := PutSideChain(s, sideChain); err != nil {
+		return fmt.Errorf("PutSideChain error: %v", err)
+	}
+	deletePendingOwner(s, sideChain.ChainID)
+
+	update, err := getUpdateSideChain(s, sideChain.ChainID)
+	if err != nil {
+		return fmt.Errorf("getUpdateSideChain error: %v", err)
+	}
+	if update != nil {
+		update.Owner = owner
+		if err := putUpdateSideChain(s, update); err != nil {
+			return fmt.Errorf("putUpdateSideChain error: %v", err)
+		}
+	}
+	return nil
+}
+
+// RegisterAsset lets the operator of a ripple chain set the vault and lock proxy addresses of its asset bind.
+//
+// Deprecated: the asset bind only carries the addresses the ripple handler routes through, it is kept for
+// ripple chains until their vault and lock proxies move to the side chain config. Assets with decimals,
+// symbols and modes of every router are registered through approveAsset
 func RegisterAsset(s *contract.ModuleContract) ([]byte, error) {
 	ctx := s.ContractRef().CurrentContext()
 	params := &RegisterAssetParam{}
@@ -390,6 +966,22 @@ func UpdateFee(s *contract.ModuleContract) ([]byte, error) {
 			fee.View, params.ViewNum)
 	}
 
+	feeParams, err := GetFeeParamsObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFee, GetFeeParamsObj error: %v", err)
+	}
+
+	//verify signature
+	digest, err := params.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFee, digest input param error: %v", err)
+	}
+	pub, err := crypto.SigToPub(digest, params.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFee, crypto.SigToPub error: %v", err)
+	}
+	addr := crypto.PubkeyToAddress(*pub)
+
 	//add fee info
 	feeInfo, err := GetFeeInfo(s, params.ChainID, fee.View)
 	if err != nil {
@@ -397,7 +989,7 @@ func UpdateFee(s *contract.ModuleContract) ([]byte, error) {
 	}
 	if feeInfo.StartHeight == 0 {
 		feeInfo.StartHeight = blockHeight
-	} else if blockHeight-feeInfo.StartHeight > UPDATE_FEE_TIMEOUT {
+	} else if blockHeight-feeInfo.StartHeight > feeParams.Timeout {
 		// if time out view + 1
 		fee.View = fee.View + 1
 		if err := PutFee(s, params.ChainID, fee); err != nil {
@@ -408,22 +1000,12 @@ func UpdateFee(s *contract.ModuleContract) ([]byte, error) {
 			FeeInfo:     make(map[common.Address]*big.Int),
 		}
 	}
-	feeInfo.FeeInfo[ctx.Caller] = params.Fee
+	// the vote is keyed by the signer whose stake weights it
+	feeInfo.FeeInfo[addr] = params.Fee
 	if err := PutFeeInfo(s, params.ChainID, fee.View, feeInfo); err != nil {
 		return nil, fmt.Errorf("UpdateFee, PutFeeInfo error: %v", err)
 	}
 
-	//verify signature
-	digest, err := params.Digest()
-	if err != nil {
-		return nil, fmt.Errorf("UpdateFee, digest input param error: %v", err)
-	}
-	pub, err := crypto.SigToPub(digest, params.Signature)
-	if err != nil {
-		return nil, fmt.Errorf("UpdateFee, crypto.SigToPub error: %v", err)
-	}
-	addr := crypto.PubkeyToAddress(*pub)
-
 	//check consensus signs
 	id := append(utils.GetUint64Bytes(params.ChainID), utils.GetUint64Bytes(fee.View)...)
 	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodUpdateFee, id, addr, node_manager.Voter)
@@ -434,21 +1016,16 @@ func UpdateFee(s *contract.ModuleContract) ([]byte, error) {
 		return nil, nil
 	}
 	//vote enough
-	feeInfoList := make([]*big.Int, 0, len(feeInfo.FeeInfo))
-	for _, v := range feeInfo.FeeInfo {
-		feeInfoList = append(feeInfoList, v)
-	}
-	sort.SliceStable(feeInfoList, func(i, j int) bool {
-		return feeInfoList[i].Cmp(feeInfoList[j]) >= 1
-	})
-	l := len(feeInfoList)
-	if l%2 == 0 {
-		//even: (a + b)*5 / 2
-		fee.Fee = new(big.Int).Div(new(big.Int).Mul(new(big.Int).Add(feeInfoList[l/2], feeInfoList[l/2-1]),
-			new(big.Int).SetUint64(5)), new(big.Int).SetUint64(2))
-	} else {
-		//odd：a * 5
-		fee.Fee = new(big.Int).Mul(feeInfoList[(l-1)/2], new(big.Int).SetUint64(5))
+	fee.Fee, err = aggregateFee(s, feeParams, feeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFee, aggregateFee error: %v", err)
+	}
+	if err := putFeeRecord(s, params.ChainID, &FeeRecord{fee.View, fee.Fee, blockHeight}); err != nil {
+		return nil, fmt.Errorf("UpdateFee, putFeeRecord error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventFeeUpdated}, params.ChainID, fee.View, fee.Fee)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateFee, AddNotify error: %v", err)
 	}
 	fee.View = fee.View + 1
 	if err := PutFee(s, params.ChainID, fee); err != nil {
@@ -19,14 +19,19 @@
 package side_chain_manager
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/polynetwork/zion-example/modules/cfg"
 	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
 	"github.com/polynetwork/zion-example/modules/node_manager"
@@ -41,14 +46,15 @@ func init() {
 }
 
 var (
-	sdb     *state.StateDB
-	signers []common.Address
+	sdb        *state.StateDB
+	signers    []common.Address
+	signerKeys []*ecdsa.PrivateKey
 )
 
 func init() {
 	node_manager.InitNodeManager()
 	sdb = contract.NewTestStateDB()
-	signers, _ = contract.GenerateTestPeers(2)
+	signers, signerKeys = contract.GenerateTestPeers(2)
 	node_manager.StoreGenesisEpoch(sdb, signers, signers)
 }
 
@@ -134,15 +140,62 @@ func testApproveRegisterSideChain(t *testing.T) {
 		assert.Equal(t, leftOverGas, extra)
 	}
 	tr.Dump()
+	testSideChainIndex(t, []uint64{8, 9})
+}
+
+func testSideChainIndex(t *testing.T, expect []uint64) {
+	caller := signers[0]
+	blockNumber := big.NewInt(1)
+	extra := uint64(2100000000)
+
+	input, err := contract.PackMethod(ABI, side_chain_manager_abi.MethodGetSideChainCount)
+	assert.Nil(t, err)
+	contractRef := contract.NewContractRef(sdb, caller, caller, blockNumber, common.Hash{}, extra, nil)
+	ret, _, err := contractRef.ModuleCall(caller, cfg.SideChainManagerContractAddress, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChainCount, uint64(len(expect)))
+	assert.Nil(t, err)
+	assert.Equal(t, ret, result)
+
+	c := contract.NewModuleContract(sdb, contractRef)
+	sideChains := make([]SideChain, 0, len(expect))
+	for _, id := range expect {
+		sideChain, err := GetSideChainObject(c, id)
+		assert.Nil(t, err)
+		sideChains = append(sideChains, *sideChain)
+	}
+	// one chain per page
+	for i := range expect {
+		input, err = (&PageParam{Offset: uint64(i), Limit: 1}).Encode()
+		assert.Nil(t, err)
+		ret, _, err = contractRef.ModuleCall(caller, cfg.SideChainManagerContractAddress, input)
+		assert.Nil(t, err)
+		result, err = contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAllSideChains, sideChains[i:i+1])
+		assert.Nil(t, err)
+		assert.Equal(t, ret, result)
+	}
+	input, err = (&PageParam{Offset: 0, Limit: MAX_PAGE_SIZE}).Encode()
+	assert.Nil(t, err)
+	ret, _, err = contractRef.ModuleCall(caller, cfg.SideChainManagerContractAddress, input)
+	assert.Nil(t, err)
+	result, err = contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetAllSideChains, sideChains)
+	assert.Nil(t, err)
+	assert.Equal(t, ret, result)
+
+	input, err = (&PageParam{Offset: 0, Limit: MAX_PAGE_SIZE + 1}).Encode()
+	assert.Nil(t, err)
+	_, _, err = contractRef.ModuleCall(caller, cfg.SideChainManagerContractAddress, input)
+	assert.NotNil(t, err)
 }
 
 func testUpdateSideChain(t *testing.T) {
 	testApproveRegisterSideChain(t)
 
-	param := new(RegisterSideChainParam)
+	param := new(UpdateSideChainParam)
 	param.ChainID = 8
 	param.Name = "own"
 	param.Router = 3
+	param.ActivationHeight = 1
 
 	input, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodUpdateSideChain, param)
 	assert.Nil(t, err)
@@ -285,6 +338,18 @@ func TestApproveQuiteSideChain(t *testing.T) {
 	input1, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveQuitSideChain, param)
 	assert.Nil(t, err)
 
+	// an active chain can not quit before it drains
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.NotNil(t, err)
+	for _, chainID := range []uint64{8, 9} {
+		status, err := (&StatusParam{ChainID: chainID, Status: STATUS_DRAINING}).Encode()
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			_, err = callSideChainManager(signer, 1, status)
+			assert.Nil(t, err)
+		}
+	}
+
 	extra := uint64(2100000000)
 	tr := contract.NewTimer(side_chain_manager_abi.MethodApproveQuitSideChain)
 	for i, input := range [][]byte{input, input1} {
@@ -319,4 +384,624 @@ func TestApproveQuiteSideChain(t *testing.T) {
 		assert.Nil(t, sideChain)
 	}
 	tr.Dump()
+	testSideChainIndex(t, []uint64{})
+}
+
+func callSideChainManager(caller common.Address, height int64, input []byte) ([]byte, error) {
+	contractRef := contract.NewContractRef(sdb, caller, caller, big.NewInt(height), common.Hash{}, uint64(2100000000), nil)
+	ret, _, err := contractRef.ModuleCall(caller, cfg.SideChainManagerContractAddress, input)
+	return ret, err
+}
+
+func TestPendingRequest(t *testing.T) {
+	register := func(chainID uint64, height int64) error {
+		input, err := (&RegisterSideChainParam{ChainID: chainID, Router: 3, Name: "pending"}).Encode()
+		assert.Nil(t, err)
+		_, err = callSideChainManager(signers[0], height, input)
+		return err
+	}
+	requestInput := func(method string, chainID uint64) []byte {
+		input, err := contract.PackMethodWithStruct(ABI, method, &RequestParam{ChainID: chainID, RequestType: REGISTER_REQUEST})
+		assert.Nil(t, err)
+		return input
+	}
+	pending := func(height int64) []uint64 {
+		input, err := (&RequestPageParam{RequestType: REGISTER_REQUEST, Limit: MAX_PAGE_SIZE}).Encode()
+		assert.Nil(t, err)
+		ret, err := callSideChainManager(signers[0], height, input)
+		assert.Nil(t, err)
+		out, err := ABI.Unpack(side_chain_manager_abi.MethodGetPendingRequests, ret)
+		assert.Nil(t, err)
+		ids := make([]uint64, 0)
+		for _, sideChain := range *abi.ConvertType(out[0], new([]SideChain)).(*[]SideChain) {
+			ids = append(ids, sideChain.ChainID)
+		}
+		return ids
+	}
+
+	assert.Nil(t, register(20, 1))
+	assert.Nil(t, register(21, 1))
+	assert.Equal(t, []uint64{20, 21}, pending(1))
+
+	ret, err := callSideChainManager(signers[0], 1, requestInput(side_chain_manager_abi.MethodGetPendingRequest, 20))
+	assert.Nil(t, err)
+	out, err := ABI.Unpack(side_chain_manager_abi.MethodGetPendingRequest, ret)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1+REQUEST_EXPIRY_PERIOD), out[1].(uint64))
+
+	// only the owner can cancel
+	_, err = callSideChainManager(signers[1], 1, requestInput(side_chain_manager_abi.MethodCancelRequest, 20))
+	assert.NotNil(t, err)
+	_, err = callSideChainManager(signers[0], 1, requestInput(side_chain_manager_abi.MethodCancelRequest, 20))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{21}, pending(1))
+
+	// rejection needs a signer quorum
+	_, err = callSideChainManager(signers[0], 1, requestInput(side_chain_manager_abi.MethodRejectRequest, 21))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{21}, pending(1))
+	_, err = callSideChainManager(signers[1], 1, requestInput(side_chain_manager_abi.MethodRejectRequest, 21))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{}, pending(1))
+
+	// a stale application no longer blocks a new one and can not be approved
+	assert.Nil(t, register(22, 1))
+	assert.NotNil(t, register(22, 2))
+	expired := int64(2 + REQUEST_EXPIRY_PERIOD)
+	assert.Equal(t, []uint64{}, pending(expired))
+	assert.Nil(t, register(22, expired))
+	assert.Equal(t, []uint64{22}, pending(expired))
+
+	later := expired + REQUEST_EXPIRY_PERIOD + 1
+	ret, err = callSideChainManager(signers[0], later, requestInput(side_chain_manager_abi.MethodApproveRegisterSideChain, 22))
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, false)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(later), common.Hash{}, 0, nil))
+	sideChain, err := GetSideChainApply(c, 22)
+	assert.Nil(t, err)
+	assert.Nil(t, sideChain)
+}
+
+func TestOwnershipTransfer(t *testing.T) {
+	input, err := (&RegisterSideChainParam{ChainID: 30, Router: 3, Name: "owned"}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: 30})
+	assert.Nil(t, err)
+	for _, signer := range signers {
+		_, err = callSideChainManager(signer, 1, input)
+		assert.Nil(t, err)
+	}
+	owner := func() common.Address {
+		c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(1), common.Hash{}, 0, nil))
+		sideChain, err := GetSideChainObject(c, 30)
+		assert.Nil(t, err)
+		return sideChain.Owner
+	}
+	assert.Equal(t, signers[0], owner())
+
+	newOwner := common.HexToAddress("0x1234")
+	transfer, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodTransferOwnership,
+		&OwnershipParam{ChainID: 30, NewOwner: newOwner})
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[1], 1, transfer)
+	assert.NotNil(t, err)
+	_, err = callSideChainManager(signers[0], 1, transfer)
+	assert.Nil(t, err)
+
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetPendingOwner, &ChainIDParam{ChainID: 30})
+	assert.Nil(t, err)
+	ret, err := callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetPendingOwner, newOwner)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+
+	// the transfer completes only when the new owner accepts
+	accept, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodAcceptOwnership, &ChainIDParam{ChainID: 30})
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[1], 1, accept)
+	assert.NotNil(t, err)
+	assert.Equal(t, signers[0], owner())
+	_, err = callSideChainManager(newOwner, 1, accept)
+	assert.Nil(t, err)
+	assert.Equal(t, newOwner, owner())
+	_, err = callSideChainManager(newOwner, 1, accept)
+	assert.NotNil(t, err)
+
+	// signers hand the chain over if the owner key is lost
+	recovery, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodRecoverOwnership,
+		&OwnershipParam{ChainID: 30, NewOwner: signers[1]})
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, recovery)
+	assert.Nil(t, err)
+	assert.Equal(t, newOwner, owner())
+	_, err = callSideChainManager(signers[1], 1, recovery)
+	assert.Nil(t, err)
+	assert.Equal(t, signers[1], owner())
+}
+
+func TestExtraInfoSchema(t *testing.T) {
+	const router = 16
+	RegisterExtraInfoSchema(router, ValidateRippleExtraInfo)
+	defer delete(extraInfoSchemas, router)
+
+	register := func(chainID uint64, info *RippleExtraInfo) error {
+		extraInfo, err := rlp.EncodeToBytes(info)
+		assert.Nil(t, err)
+		input, err := (&RegisterSideChainParam{ChainID: chainID, Router: router, Name: "ripple", ExtraInfo: extraInfo}).Encode()
+		assert.Nil(t, err)
+		_, err = callSideChainManager(signers[0], 1, input)
+		return err
+	}
+	pks := [][]byte{make([]byte, RIPPLE_PK_SIZE), make([]byte, RIPPLE_PK_SIZE)}
+	pks[0][0], pks[1][0] = 0x02, 0x03
+	valid := func() *RippleExtraInfo {
+		return &RippleExtraInfo{Operator: signers[0], Quorum: 2, SignerNum: 2, Pks: pks, ReserveAmount: big.NewInt(10)}
+	}
+
+	info := valid()
+	info.Quorum = 3
+	assert.NotNil(t, register(40, info))
+	info = valid()
+	info.Pks = [][]byte{pks[0], pks[0]}
+	assert.NotNil(t, register(40, info))
+	info = valid()
+	info.Pks = [][]byte{pks[0], pks[1][:20]}
+	assert.NotNil(t, register(40, info))
+	info = valid()
+	info.Operator = common.Address{}
+	assert.NotNil(t, register(40, info))
+	assert.Nil(t, register(40, valid()))
+
+	assert.Nil(t, ValidateEthExtraInfo(nil))
+	extraInfo, err := rlp.EncodeToBytes(&EthExtraInfo{Confirmations: MAX_CONFIRMATIONS + 1})
+	assert.Nil(t, err)
+	assert.NotNil(t, ValidateEthExtraInfo(extraInfo))
+	assert.NotNil(t, ValidateEthExtraInfo([]byte{0x01, 0x02}))
+}
+
+func TestAssetRegistry(t *testing.T) {
+	for _, chainID := range []uint64{50, 51} {
+		input, err := (&RegisterSideChainParam{ChainID: chainID, Router: 3, Name: "asset"}).Encode()
+		assert.Nil(t, err)
+		_, err = callSideChainManager(signers[0], 1, input)
+		assert.Nil(t, err)
+		input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: chainID})
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			_, err = callSideChainManager(signer, 1, input)
+			assert.Nil(t, err)
+		}
+	}
+	vote := func(method string, param interface{}) {
+		input, err := contract.PackMethodWithStruct(ABI, method, param)
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			_, err = callSideChainManager(signer, 1, input)
+			assert.Nil(t, err)
+		}
+	}
+	assets := func(chainID uint64) []Asset {
+		input, err := (&AssetPageParam{ChainID: chainID, Limit: MAX_PAGE_SIZE}).Encode()
+		assert.Nil(t, err)
+		ret, err := callSideChainManager(signers[0], 1, input)
+		assert.Nil(t, err)
+		out, err := ABI.Unpack(side_chain_manager_abi.MethodGetAssets, ret)
+		assert.Nil(t, err)
+		return *abi.ConvertType(out[0], new([]Asset)).(*[]Asset)
+	}
+
+	xrp := &AssetParam{ChainID: 50, Asset: []byte{1}, Symbol: "XRP", Decimals: 6, Mode: ASSET_MODE_LOCK}
+	wxrp := &AssetParam{ChainID: 51, Asset: []byte{2}, Symbol: "XRP", Decimals: 18, Mode: ASSET_MODE_MINT}
+	vote(side_chain_manager_abi.MethodApproveAsset, xrp)
+	vote(side_chain_manager_abi.MethodApproveAsset, wxrp)
+	assert.Equal(t, []Asset{{50, []byte{1}, "XRP", 6, ASSET_MODE_LOCK}}, assets(50))
+
+	// a symbol belongs to one asset per chain, and unknown modes are refused
+	input, err := (&AssetParam{ChainID: 50, Asset: []byte{3}, Symbol: "XRP", Decimals: 6}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.NotNil(t, err)
+	input, err = (&AssetParam{ChainID: 50, Asset: []byte{3}, Symbol: "ETH", Decimals: 18, Mode: 2}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.NotNil(t, err)
+
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(1), common.Hash{}, 0, nil))
+	amount, err := ConvertAmount(c, 50, []byte{1}, 51, big.NewInt(1500000), true)
+	assert.Nil(t, err)
+	assert.Equal(t, "1500000000000000000", amount.String())
+	amount, err = ConvertAmount(c, 50, []byte{1}, 51, big.NewInt(1500000000000000123), false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1500000), amount.Int64())
+	amount, err = ConvertAmount(c, 50, []byte{1}, 52, big.NewInt(7), true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), amount.Int64())
+
+	remove := &AssetKeyParam{ChainID: 50, Asset: []byte{1}}
+	vote(side_chain_manager_abi.MethodRemoveAsset, remove)
+	assert.Equal(t, []Asset{}, assets(50))
+	amount, err = ConvertAmount(c, 50, []byte{1}, 51, big.NewInt(7), true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), amount.Int64())
+
+	// the same entry can be approved and removed again
+	vote(side_chain_manager_abi.MethodApproveAsset, xrp)
+	assert.Equal(t, 1, len(assets(50)))
+	vote(side_chain_manager_abi.MethodRemoveAsset, remove)
+	assert.Equal(t, 0, len(assets(50)))
+}
+
+func TestFeeOracle(t *testing.T) {
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(1), common.Hash{}, 0, nil))
+	feeParams, err := GetFeeParamsObj(c, 60)
+	assert.Nil(t, err)
+	votes := func(fees ...int64) *FeeInfo {
+		feeInfo := &FeeInfo{FeeInfo: make(map[common.Address]*big.Int)}
+		for i, fee := range fees {
+			feeInfo.FeeInfo[common.BigToAddress(big.NewInt(int64(i+1)))] = big.NewInt(fee)
+		}
+		return feeInfo
+	}
+
+	// chains without params keep median * 5
+	fee, err := aggregateFee(c, feeParams, votes(30, 10, 20))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), fee.Int64())
+	fee, err = aggregateFee(c, feeParams, votes(10, 11, 40, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(52), fee.Int64())
+
+	input, err := (&FeeParamsParam{ChainID: 60, Multiplier: 200, MinFee: big.NewInt(25), MaxFee: big.NewInt(50), Timeout: 10}).Encode()
+	assert.Nil(t, err)
+	for _, signer := range signers {
+		_, err = callSideChainManager(signer, 1, input)
+		assert.Nil(t, err)
+	}
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetFeeParams, &ChainIDParam{ChainID: 60})
+	assert.Nil(t, err)
+	ret, err := callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeParams, uint64(200), big.NewInt(25),
+		big.NewInt(50), uint64(10), false)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+
+	feeParams, err = GetFeeParamsObj(c, 60)
+	assert.Nil(t, err)
+	fee, err = aggregateFee(c, feeParams, votes(5, 10, 15))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(25), fee.Int64())
+	fee, err = aggregateFee(c, feeParams, votes(20, 30, 40))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(50), fee.Int64())
+
+	// genesis voters have no stake, the plain median is used
+	feeParams.StakeWeighted = true
+	fee, err = aggregateFee(c, feeParams, votes(12, 13, 20))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(26), fee.Int64())
+
+	// the history keeps the last FEE_HISTORY_SIZE views
+	for view := uint64(0); view < FEE_HISTORY_SIZE+2; view++ {
+		assert.Nil(t, putFeeRecord(c, 60, &FeeRecord{view, new(big.Int).SetUint64(view), view}))
+	}
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetFeeHistory, &ChainIDParam{ChainID: 60})
+	assert.Nil(t, err)
+	ret, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	out, err := ABI.Unpack(side_chain_manager_abi.MethodGetFeeHistory, ret)
+	assert.Nil(t, err)
+	records := *abi.ConvertType(out[0], new([]FeeRecord)).(*[]FeeRecord)
+	assert.Equal(t, FEE_HISTORY_SIZE, len(records))
+	assert.Equal(t, uint64(2), records[0].View)
+
+	feeInfo := votes(7, 3)
+	feeInfo.StartHeight = 9
+	assert.Nil(t, PutFeeInfo(c, 60, 4, feeInfo))
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetFeeInfo, &FeeViewParam{ChainID: 60, ViewNum: 4})
+	assert.Nil(t, err)
+	ret, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	result, err = contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetFeeInfo, uint64(9),
+		[]common.Address{common.BigToAddress(big.NewInt(2)), common.BigToAddress(big.NewInt(1))},
+		[]*big.Int{big.NewInt(3), big.NewInt(7)})
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+
+	// a fee vote is keyed by its signer whoever submits it, and a bad signature stores nothing
+	update := &UpdateFeeParam{ChainID: 61, Fee: big.NewInt(30)}
+	digest, err := update.Digest()
+	assert.Nil(t, err)
+	update.Signature, err = crypto.Sign(digest, signerKeys[0])
+	assert.Nil(t, err)
+	input, err = update.Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[1], 1, input)
+	assert.Nil(t, err)
+	update.Signature = []byte{1}
+	input, err = update.Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[1], 1, input)
+	assert.NotNil(t, err)
+	feeInfo, err = GetFeeInfo(c, 61, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, map[common.Address]*big.Int{signers[0]: big.NewInt(30)}, feeInfo.FeeInfo)
+}
+
+func TestSideChainHistory(t *testing.T) {
+	synced := uint64(0)
+	RegisterSyncedHeight(func(*contract.ModuleContract, uint64) (uint64, error) { return synced, nil })
+	defer RegisterSyncedHeight(nil)
+
+	register := func() {
+		input, err := (&RegisterSideChainParam{ChainID: 70, Router: 3, Name: "versioned", CCMCAddress: []byte{1}}).Encode()
+		assert.Nil(t, err)
+		_, err = callSideChainManager(signers[0], 1, input)
+		assert.Nil(t, err)
+	}
+	update := func(ccmc byte, activation uint64) error {
+		input, err := (&UpdateSideChainParam{ChainID: 70, Router: 3, Name: "versioned", CCMCAddress: []byte{ccmc},
+			ActivationHeight: activation}).Encode()
+		assert.Nil(t, err)
+		_, err = callSideChainManager(signers[0], 1, input)
+		return err
+	}
+	approve := func(method string) error {
+		input, err := contract.PackMethodWithStruct(ABI, method, &ChainIDParam{ChainID: 70})
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			if _, err = callSideChainManager(signer, 1, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	ccmcAt := func(height uint64) []byte {
+		input, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetSideChainAtHeight,
+			&HeightParam{ChainID: 70, Height: height})
+		assert.Nil(t, err)
+		ret, err := callSideChainManager(signers[0], 1, input)
+		assert.Nil(t, err)
+		out, err := ABI.Unpack(side_chain_manager_abi.MethodGetSideChainAtHeight, ret)
+		assert.Nil(t, err)
+		return abi.ConvertType(out[0], new(SideChain)).(*SideChain).CCMCAddress
+	}
+
+	register()
+	assert.Nil(t, approve(side_chain_manager_abi.MethodApproveRegisterSideChain))
+	synced = 10
+	assert.NotNil(t, update(2, 10))
+	assert.Nil(t, update(2, 11))
+	assert.Nil(t, approve(side_chain_manager_abi.MethodApproveUpdateSideChain))
+	synced = 20
+	assert.Nil(t, update(3, 25))
+	assert.Nil(t, approve(side_chain_manager_abi.MethodApproveUpdateSideChain))
+
+	assert.Equal(t, []byte{1}, ccmcAt(0))
+	assert.Equal(t, []byte{1}, ccmcAt(10))
+	assert.Equal(t, []byte{2}, ccmcAt(11))
+	assert.Equal(t, []byte{2}, ccmcAt(24))
+	assert.Equal(t, []byte{3}, ccmcAt(25))
+
+	// an update activating at or below an unused version drops it
+	assert.Nil(t, update(4, 21))
+	assert.Nil(t, approve(side_chain_manager_abi.MethodApproveUpdateSideChain))
+	assert.Equal(t, []byte{2}, ccmcAt(20))
+	assert.Equal(t, []byte{4}, ccmcAt(21))
+	assert.Equal(t, []byte{4}, ccmcAt(25))
+
+	// a request whose activation height is synced before it is approved is refused
+	assert.Nil(t, update(5, 30))
+	synced = 30
+	assert.NotNil(t, approve(side_chain_manager_abi.MethodApproveUpdateSideChain))
+	assert.Equal(t, []byte{4}, ccmcAt(30))
+}
+
+func TestSideChainStatus(t *testing.T) {
+	input, err := (&RegisterSideChainParam{ChainID: 80, Router: 3, Name: "status"}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: 80})
+	assert.Nil(t, err)
+	for _, signer := range signers {
+		_, err = callSideChainManager(signer, 1, input)
+		assert.Nil(t, err)
+	}
+	setStatus := func(status uint8) error {
+		input, err := (&StatusParam{ChainID: 80, Status: status}).Encode()
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			if _, err = callSideChainManager(signer, 1, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(1), common.Hash{}, 0, nil))
+	check := func(source, target bool) {
+		assert.Equal(t, source, CheckSourceStatus(c, 80) == nil)
+		assert.Equal(t, target, CheckTargetStatus(c, 80) == nil)
+	}
+
+	check(true, true)
+	assert.Nil(t, setStatus(STATUS_PAUSED))
+	check(false, false)
+	assert.Nil(t, setStatus(STATUS_ACTIVE))
+	check(true, true)
+	// the same transition can be voted again
+	assert.Nil(t, setStatus(STATUS_PAUSED))
+	check(false, false)
+	assert.Nil(t, setStatus(STATUS_DRAINING))
+	check(true, false)
+	assert.NotNil(t, setStatus(STATUS_DRAINING))
+	assert.NotNil(t, setStatus(STATUS_DEPRECATED+1))
+	assert.Nil(t, setStatus(STATUS_DEPRECATED))
+	check(false, false)
+	assert.NotNil(t, setStatus(STATUS_ACTIVE))
+
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetSideChainStatus, &ChainIDParam{ChainID: 80})
+	assert.Nil(t, err)
+	ret, err := callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChainStatus, STATUS_DEPRECATED)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+}
+
+func TestSideChainCleanup(t *testing.T) {
+	input, err := (&RegisterSideChainParam{ChainID: 90, Router: 3, Name: "cleanup"}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	vote := func(method string, param interface{}) {
+		input, err := contract.PackMethodWithStruct(ABI, method, param)
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			_, err = callSideChainManager(signer, 1, input)
+			assert.Nil(t, err)
+		}
+	}
+	vote(side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: 90})
+	vote(side_chain_manager_abi.MethodApproveAsset, &AssetParam{ChainID: 90, Asset: []byte{1}, Symbol: "XRP", Decimals: 6})
+
+	// fee votes of more views than one batch can delete
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, signers[0], signers[0], big.NewInt(1), common.Hash{}, 0, nil))
+	views := uint64(CLEANUP_BATCH_SIZE + 10)
+	for view := uint64(0); view < views; view++ {
+		assert.Nil(t, PutFeeInfo(c, 90, view, &FeeInfo{StartHeight: 1, FeeInfo: map[common.Address]*big.Int{signers[0]: big.NewInt(1)}}))
+	}
+	assert.Nil(t, PutFee(c, 90, &Fee{View: views - 1, Fee: big.NewInt(10)}))
+
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodQuitSideChain, &ChainIDParam{ChainID: 90})
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.Nil(t, err)
+	// the chain can not be deprecated while a module has transfers of it in flight
+	drained := false
+	RegisterDrainCheck("side_chain_manager.test", func(*contract.ModuleContract, uint64) (bool, error) { return drained, nil })
+	input, err = (&StatusParam{ChainID: 90, Status: STATUS_DEPRECATED}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.NotNil(t, err)
+	drained = true
+	vote(side_chain_manager_abi.MethodSetSideChainStatus, &StatusParam{ChainID: 90, Status: STATUS_DEPRECATED})
+	vote(side_chain_manager_abi.MethodApproveQuitSideChain, &ChainIDParam{ChainID: 90})
+	assert.NotNil(t, getQuitSideChain(c, 90))
+
+	// the chain can not be registered again before its state is cleaned up
+	input, err = (&RegisterSideChainParam{ChainID: 90, Router: 3, Name: "cleanup"}).Encode()
+	assert.Nil(t, err)
+	_, err = callSideChainManager(signers[0], 1, input)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, ProcessCleanup(c))
+	pending, err := isCleanupPending(c, 90)
+	assert.Nil(t, err)
+	assert.True(t, pending)
+	feeInfo, err := GetFeeInfo(c, 90, views-1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(feeInfo.FeeInfo))
+
+	assert.Nil(t, ProcessCleanup(c))
+	pending, err = isCleanupPending(c, 90)
+	assert.Nil(t, err)
+	assert.False(t, pending)
+	for _, view := range []uint64{0, views - 1} {
+		feeInfo, err = GetFeeInfo(c, 90, view)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(feeInfo.FeeInfo))
+	}
+	fee, err := GetFeeObj(c, 90)
+	assert.Nil(t, err)
+	assert.Equal(t, views, fee.View)
+	assert.Equal(t, 0, fee.Fee.Sign())
+	entry, err := GetAssetEntry(c, 90, []byte{1})
+	assert.Nil(t, err)
+	assert.Nil(t, entry)
+	history, err := getSideChainHistory(c, 90)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history))
+
+	// the cleaned chain can be registered and approved again
+	_, err = callSideChainManager(signers[0], 2, input)
+	assert.Nil(t, err)
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: 90})
+	assert.Nil(t, err)
+	for _, signer := range signers {
+		_, err = callSideChainManager(signer, 2, input)
+		assert.Nil(t, err)
+	}
+	sideChain, err := GetSideChainObject(c, 90)
+	assert.Nil(t, err)
+	assert.NotNil(t, sideChain)
+}
+
+func TestRegistrationBond(t *testing.T) {
+	vote := func(method string, param interface{}) {
+		input, err := contract.PackMethodWithStruct(ABI, method, param)
+		assert.Nil(t, err)
+		for _, signer := range signers {
+			_, err = callSideChainManager(signer, 1, input)
+			assert.Nil(t, err)
+		}
+	}
+	bond := big.NewInt(1000)
+	vote(side_chain_manager_abi.MethodSetBondParams, &BondParamsParam{RegisterBond: bond, UpdateBond: new(big.Int)})
+	defer vote(side_chain_manager_abi.MethodSetBondParams, &BondParamsParam{RegisterBond: new(big.Int), UpdateBond: new(big.Int)})
+
+	payer := signers[0]
+	sdb.AddBalance(payer, big.NewInt(10000))
+	register := func(chainID uint64, value *big.Int, pay bool) error {
+		input, err := (&RegisterSideChainParam{ChainID: chainID, Router: 3, Name: "bond"}).Encode()
+		assert.Nil(t, err)
+		contractRef := contract.NewContractRef(sdb, payer, payer, big.NewInt(1), common.Hash{}, uint64(2100000000), nil)
+		contractRef.SetValue(value)
+		if pay {
+			assert.Nil(t, utils.ModuleTransfer(sdb, payer, cfg.SideChainManagerContractAddress, value))
+		}
+		_, _, err = contractRef.ModuleCall(payer, cfg.SideChainManagerContractAddress, input)
+		return err
+	}
+	assert.NotNil(t, register(100, new(big.Int), false))
+	assert.NotNil(t, register(100, big.NewInt(999), false))
+	for _, chainID := range []uint64{100, 101, 102} {
+		assert.Nil(t, register(chainID, bond, true))
+	}
+	assert.Equal(t, big.NewInt(7000), sdb.GetBalance(payer))
+
+	input, err := contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodGetRequestBond, &RequestParam{ChainID: 100, RequestType: REGISTER_REQUEST})
+	assert.Nil(t, err)
+	ret, err := callSideChainManager(payer, 1, input)
+	assert.Nil(t, err)
+	result, err := contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetRequestBond, payer, bond)
+	assert.Nil(t, err)
+	assert.Equal(t, result, ret)
+
+	// refunded on approval and on cancellation by the owner
+	vote(side_chain_manager_abi.MethodApproveRegisterSideChain, &ChainIDParam{ChainID: 100})
+	assert.Equal(t, big.NewInt(8000), sdb.GetBalance(payer))
+	input, err = contract.PackMethodWithStruct(ABI, side_chain_manager_abi.MethodCancelRequest, &RequestParam{ChainID: 101, RequestType: REGISTER_REQUEST})
+	assert.Nil(t, err)
+	_, err = callSideChainManager(payer, 1, input)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(9000), sdb.GetBalance(payer))
+
+	// sent to the community pool on rejection
+	pool := sdb.GetBalance(common.EmptyAddress)
+	vote(side_chain_manager_abi.MethodRejectRequest, &RequestParam{ChainID: 102, RequestType: REGISTER_REQUEST})
+	assert.Equal(t, big.NewInt(9000), sdb.GetBalance(payer))
+	assert.Equal(t, new(big.Int).Add(pool, bond), sdb.GetBalance(common.EmptyAddress))
+
+	c := contract.NewModuleContract(sdb, contract.NewContractRef(sdb, payer, payer, big.NewInt(1), common.Hash{}, 0, nil))
+	for _, chainID := range []uint64{100, 101, 102} {
+		held, err := getBond(c, REGISTER_REQUEST, chainID)
+		assert.Nil(t, err)
+		assert.Nil(t, held)
+	}
 }
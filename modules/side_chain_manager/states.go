@@ -41,6 +41,41 @@ type Fee struct {
 	Fee  *big.Int
 }
 
+// FeeParams configures how voted fees of a chain are aggregated
+type FeeParams struct {
+	// percent applied to the median of votes
+	Multiplier uint64
+	// bounds of the aggregated fee, 0 means unbounded
+	MinFee *big.Int
+	MaxFee *big.Int
+	// blocks a fee view stays open for votes
+	Timeout uint64
+	// weight votes by the stake of voters instead of one vote per voter
+	StakeWeighted bool
+	// bumped on every change so signers can vote for the same params again
+	Revision uint64
+}
+
+// BondParams are the native token bonds a side chain request has to pay, 0 means no bond
+type BondParams struct {
+	RegisterBond *big.Int
+	UpdateBond   *big.Int
+	// bumped on every change so signers can vote for the same params again
+	Revision uint64
+}
+
+// Bond is held by the side chain manager until the request it was paid for is settled
+type Bond struct {
+	Payer  common.Address
+	Amount *big.Int
+}
+
+type FeeRecord struct {
+	View   uint64
+	Fee    *big.Int
+	Height uint64
+}
+
 type FeeInfo struct {
 	StartHeight uint64
 	FeeInfo     map[common.Address]*big.Int
@@ -89,8 +124,35 @@ type RippleExtraInfo struct {
 	SignerNum     uint64
 	Pks           [][]byte
 	ReserveAmount *big.Int
+	// deposits are proven against ledger headers synced by info_sync instead of voter attestations
+	ProofMode bool `rlp:"optional"`
+	// outbound payments are queued and flushed in batches every BatchInterval blocks, 0 disables batching
+	BatchInterval uint64 `rlp:"optional"`
+	// drops the multisign account must keep after all pending payments, nil disables vault checks
+	VaultReserve *big.Int `rlp:"optional"`
+}
+
+type EthExtraInfo struct {
+	// blocks on top of a header before its root info may be used, 0 accepts any synced header
+	Confirmations uint64
+	// root infos are rlp encoded headers that info_sync links to the stored header chain
+	HeaderSync bool `rlp:"optional"`
+	// clique signers a synced header has to be sealed by, empty skips the seal check
+	Sealers []common.Address `rlp:"optional"`
+}
+
+// Asset is an entry of the asset registry, the same asset on different chains shares one symbol
+type Asset struct {
+	ChainID  uint64
+	Asset    []byte
+	Symbol   string
+	Decimals uint8
+	Mode     uint8
 }
 
+// AssetBind is the ripple vault of a chain in AssetMap and the lock proxies of its peer chains in LockProxyMap.
+//
+// Deprecated: set by the operator through RegisterAsset for ripple chains only, see Asset for the asset registry
 type AssetBind struct {
 	AssetMap     map[uint64][]byte
 	LockProxyMap map[uint64][]byte
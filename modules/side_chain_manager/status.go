@@ -0,0 +1,205 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package side_chain_manager
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/contract"
+	"github.com/ethereum/go-ethereum/contract/utils"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/polynetwork/zion-example/modules/go_abi/side_chain_manager_abi"
+	"github.com/polynetwork/zion-example/modules/node_manager"
+)
+
+// statuses of registered side chains
+const (
+	// transfers from and to the chain are processed
+	STATUS_ACTIVE uint8 = iota
+	// no transfer from or to the chain is processed
+	STATUS_PAUSED
+	// transfers from the chain are still processed so assets can leave it, new transfers to it are rejected
+	STATUS_DRAINING
+	// the chain is retired and waits to quit, it can not become active again
+	STATUS_DEPRECATED
+)
+
+type SideChainStatus struct {
+	Status uint8
+	// bumped on every change so signers can vote for the same status again
+	Revision uint64
+}
+
+// SetSideChainStatus moves a side chain to another status once a signer quorum votes for it
+func SetSideChainStatus(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &StatusParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodSetSideChainStatus, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	if params.Status > STATUS_DEPRECATED {
+		return nil, fmt.Errorf("SetSideChainStatus, unknown status %d", params.Status)
+	}
+	sideChain, err := GetSideChainObject(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetSideChainStatus, GetSideChainObject error: %v", err)
+	}
+	if sideChain == nil {
+		return nil, fmt.Errorf("SetSideChainStatus, side chain %d is not registered", params.ChainID)
+	}
+	status, err := GetSideChainStatusObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("SetSideChainStatus, GetSideChainStatusObj error: %v", err)
+	}
+	if status.Status == params.Status {
+		return nil, fmt.Errorf("SetSideChainStatus, side chain %d is already in status %d", params.ChainID, params.Status)
+	}
+	if status.Status == STATUS_DEPRECATED {
+		return nil, fmt.Errorf("SetSideChainStatus, side chain %d is deprecated", params.ChainID)
+	}
+
+	// a deprecated chain can only quit, so no transfer of it may be left in flight
+	if params.Status == STATUS_DEPRECATED {
+		if err := checkDrained(s, params.ChainID); err != nil {
+			return nil, fmt.Errorf("SetSideChainStatus, %v", err)
+		}
+	}
+
+	id := append(utils.GetUint64Bytes(params.ChainID), params.Status)
+	id = append(id, utils.GetUint64Bytes(status.Revision)...)
+	ok, err := node_manager.CheckConsensusSigns(s, side_chain_manager_abi.MethodSetSideChainStatus, id,
+		s.ContractRef().TxOrigin(), node_manager.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("SetSideChainStatus, CheckConsensusSigns error: %v", err)
+	}
+	if !ok {
+		return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetSideChainStatus, true)
+	}
+
+	previous := status.Status
+	status.Status = params.Status
+	status.Revision = status.Revision + 1
+	if err := putSideChainStatus(s, params.ChainID, status); err != nil {
+		return nil, fmt.Errorf("SetSideChainStatus, putSideChainStatus error: %v", err)
+	}
+	err = s.AddNotify(ABI, []string{EventSideChainStatusChanged}, params.ChainID, previous, status.Status)
+	if err != nil {
+		return nil, fmt.Errorf("SetSideChainStatus, AddNotify error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodSetSideChainStatus, true)
+}
+
+func GetSideChainStatus(s *contract.ModuleContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	params := &ChainIDParam{}
+	if err := contract.UnpackMethod(ABI, side_chain_manager_abi.MethodGetSideChainStatus, params, ctx.Payload); err != nil {
+		return nil, err
+	}
+	status, err := GetSideChainStatusObj(s, params.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("GetSideChainStatus, GetSideChainStatusObj error: %v", err)
+	}
+	return contract.PackOutputs(ABI, side_chain_manager_abi.MethodGetSideChainStatus, status.Status)
+}
+
+// CheckSourceStatus returns an error if transfers from a side chain are not processed
+func CheckSourceStatus(module *contract.ModuleContract, chainID uint64) error {
+	status, err := GetSideChainStatusObj(module, chainID)
+	if err != nil {
+		return err
+	}
+	if status.Status != STATUS_ACTIVE && status.Status != STATUS_DRAINING {
+		return fmt.Errorf("side chain %d does not accept outgoing transfers in status %d", chainID, status.Status)
+	}
+	return nil
+}
+
+// CheckTargetStatus returns an error if new transfers to a side chain are not processed
+func CheckTargetStatus(module *contract.ModuleContract, chainID uint64) error {
+	status, err := GetSideChainStatusObj(module, chainID)
+	if err != nil {
+		return err
+	}
+	if status.Status != STATUS_ACTIVE {
+		return fmt.Errorf("side chain %d does not accept incoming transfers in status %d", chainID, status.Status)
+	}
+	return nil
+}
+
+// ChainDrainCheck reports whether a module still has transfers of a side chain in flight
+type ChainDrainCheck func(module *contract.ModuleContract, chainID uint64) (drained bool, err error)
+
+type chainDrainCheck struct {
+	name  string
+	check ChainDrainCheck
+}
+
+var drainChecks []chainDrainCheck
+
+// RegisterDrainCheck registers the drain check of a module, registering the same name again replaces it in place
+func RegisterDrainCheck(name string, check ChainDrainCheck) {
+	for i, v := range drainChecks {
+		if v.name == name {
+			drainChecks[i].check = check
+			return
+		}
+	}
+	drainChecks = append(drainChecks, chainDrainCheck{name, check})
+}
+
+// checkDrained returns an error if any module still has transfers of a side chain in flight
+func checkDrained(module *contract.ModuleContract, chainID uint64) error {
+	for _, v := range drainChecks {
+		drained, err := v.check(module, chainID)
+		if err != nil {
+			return fmt.Errorf("drain check %s of chain %d error: %v", v.name, chainID, err)
+		}
+		if !drained {
+			return fmt.Errorf("side chain %d is not drained by %s", chainID, v.name)
+		}
+	}
+	return nil
+}
+
+func sideChainStatusKey(chainID uint64) []byte {
+	return utils.ConcatKey(this, []byte(SIDE_CHAIN_STATUS), utils.GetUint64Bytes(chainID))
+}
+
+// GetSideChainStatusObj returns the status of a side chain, chains without a status are active
+func GetSideChainStatusObj(module *contract.ModuleContract, chainID uint64) (*SideChainStatus, error) {
+	store, err := module.GetCacheDB().Get(sideChainStatusKey(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("GetSideChainStatusObj, get side chain status store error: %v", err)
+	}
+	status := new(SideChainStatus)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, status); err != nil {
+			return nil, fmt.Errorf("GetSideChainStatusObj, deserialize side chain status error: %v", err)
+		}
+	}
+	return status, nil
+}
+
+func putSideChainStatus(module *contract.ModuleContract, chainID uint64, status *SideChainStatus) error {
+	blob, err := rlp.EncodeToBytes(status)
+	if err != nil {
+		return fmt.Errorf("putSideChainStatus, rlp.EncodeToBytes side chain status error: %v", err)
+	}
+	return module.GetCacheDB().Put(sideChainStatusKey(chainID), blob)
+}
@@ -21,6 +21,7 @@ package side_chain_manager
 import (
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contract"
@@ -101,6 +102,166 @@ func PutSideChain(module *contract.ModuleContract, sideChain *SideChain) error {
 	return nil
 }
 
+// getChainIndex returns the chain ids stored under key in ascending order
+func getChainIndex(module *contract.ModuleContract, key []byte) ([]uint64, error) {
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("getChainIndex, get chain index store error: %v", err)
+	}
+	index := make([]uint64, 0)
+	if store != nil {
+		if err := rlp.DecodeBytes(store, &index); err != nil {
+			return nil, fmt.Errorf("getChainIndex, deserialize chain index error: %v", err)
+		}
+	}
+	return index, nil
+}
+
+func putChainIndex(module *contract.ModuleContract, key []byte, index []uint64) error {
+	blob, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return fmt.Errorf("putChainIndex, rlp.EncodeToBytes chain index error: %v", err)
+	}
+	return module.GetCacheDB().Put(key, blob)
+}
+
+func addChainIndex(module *contract.ModuleContract, key []byte, chainID uint64) error {
+	index, err := getChainIndex(module, key)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(index), func(i int) bool { return index[i] >= chainID })
+	if i < len(index) && index[i] == chainID {
+		return nil
+	}
+	index = append(index, 0)
+	copy(index[i+1:], index[i:])
+	index[i] = chainID
+	return putChainIndex(module, key, index)
+}
+
+func removeChainIndex(module *contract.ModuleContract, key []byte, chainID uint64) error {
+	index, err := getChainIndex(module, key)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(index), func(i int) bool { return index[i] >= chainID })
+	if i == len(index) || index[i] != chainID {
+		return nil
+	}
+	return putChainIndex(module, key, append(index[:i], index[i+1:]...))
+}
+
+func sideChainIndexKey() []byte {
+	return utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(SIDE_CHAIN_INDEX))
+}
+
+func requestIndexKey(requestType uint8) []byte {
+	return utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(PENDING_REQUEST_INDEX), []byte{requestType})
+}
+
+func requestExpiryKey(requestType uint8, chainID uint64) []byte {
+	return utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(REQUEST_EXPIRY), []byte{requestType},
+		utils.GetUint64Bytes(chainID))
+}
+
+func requestKey(requestType uint8, chainID uint64) ([]byte, error) {
+	var prefix string
+	switch requestType {
+	case REGISTER_REQUEST:
+		prefix = SIDE_CHAIN_APPLY
+	case UPDATE_REQUEST:
+		prefix = UPDATE_SIDE_CHAIN_REQUEST
+	case QUIT_REQUEST:
+		prefix = QUIT_SIDE_CHAIN_REQUEST
+	default:
+		return nil, fmt.Errorf("unknown request type %d", requestType)
+	}
+	return utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(prefix), utils.GetUint64Bytes(chainID)), nil
+}
+
+// getPendingRequest returns the side chain a request would result in, the current side chain for quit requests
+func getPendingRequest(module *contract.ModuleContract, requestType uint8, chainID uint64) (*SideChain, error) {
+	switch requestType {
+	case REGISTER_REQUEST:
+		return GetSideChainApply(module, chainID)
+	case UPDATE_REQUEST:
+		return getUpdateSideChain(module, chainID)
+	case QUIT_REQUEST:
+		if err := getQuitSideChain(module, chainID); err != nil {
+			return nil, nil
+		}
+		return GetSideChainObject(module, chainID)
+	default:
+		return nil, fmt.Errorf("getPendingRequest, unknown request type %d", requestType)
+	}
+}
+
+// putRequestExpiry starts the expiry period of a new request and indexes it
+func putRequestExpiry(module *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	expiry := module.ContractRef().BlockHeight().Uint64() + REQUEST_EXPIRY_PERIOD
+	if err := module.GetCacheDB().Put(requestExpiryKey(requestType, chainID), utils.GetUint64Bytes(expiry)); err != nil {
+		return fmt.Errorf("putRequestExpiry, put expiry error: %v", err)
+	}
+	return addChainIndex(module, requestIndexKey(requestType), chainID)
+}
+
+// getRequestExpiry returns 0 for requests made before requests could expire
+func getRequestExpiry(module *contract.ModuleContract, requestType uint8, chainID uint64) (uint64, error) {
+	store, err := module.GetCacheDB().Get(requestExpiryKey(requestType, chainID))
+	if err != nil {
+		return 0, fmt.Errorf("getRequestExpiry, get expiry store error: %v", err)
+	}
+	if store == nil {
+		return 0, nil
+	}
+	return utils.GetBytesUint64(store), nil
+}
+
+func isRequestExpired(module *contract.ModuleContract, requestType uint8, chainID uint64) (bool, error) {
+	expiry, err := getRequestExpiry(module, requestType, chainID)
+	if err != nil {
+		return false, err
+	}
+	return expiry != 0 && module.ContractRef().BlockHeight().Uint64() > expiry, nil
+}
+
+// clearRequestExpiry drops the expiry and index entry of a request that is approved or dropped
+func clearRequestExpiry(module *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	module.GetCacheDB().Delete(requestExpiryKey(requestType, chainID))
+	return removeChainIndex(module, requestIndexKey(requestType), chainID)
+}
+
+func deleteRequest(module *contract.ModuleContract, requestType uint8, chainID uint64) error {
+	key, err := requestKey(requestType, chainID)
+	if err != nil {
+		return fmt.Errorf("deleteRequest, %v", err)
+	}
+	module.GetCacheDB().Delete(key)
+	if requestType == UPDATE_REQUEST {
+		module.GetCacheDB().Delete(activationHeightKey(chainID))
+	}
+	return clearRequestExpiry(module, requestType, chainID)
+}
+
+func getPendingOwner(module *contract.ModuleContract, chainID uint64) (common.Address, error) {
+	key := utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(PENDING_OWNER), utils.GetUint64Bytes(chainID))
+	store, err := module.GetCacheDB().Get(key)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("getPendingOwner, get pending owner store error: %v", err)
+	}
+	return common.BytesToAddress(store), nil
+}
+
+func putPendingOwner(module *contract.ModuleContract, chainID uint64, owner common.Address) error {
+	key := utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(PENDING_OWNER), utils.GetUint64Bytes(chainID))
+	return module.GetCacheDB().Put(key, owner.Bytes())
+}
+
+func deletePendingOwner(module *contract.ModuleContract, chainID uint64) {
+	module.GetCacheDB().Delete(utils.ConcatKey(cfg.SideChainManagerContractAddress, []byte(PENDING_OWNER), utils.GetUint64Bytes(chainID)))
+}
+
 func getUpdateSideChain(module *contract.ModuleContract, chanid uint64) (*SideChain, error) {
 	contractAddr := cfg.SideChainManagerContractAddress
 	chainidByte := utils.GetUint64Bytes(chanid)
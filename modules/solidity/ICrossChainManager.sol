@@ -6,13 +6,30 @@ interface ICrossChainManager {
     event ReplenishEvent(string[] txHashes, uint64 chainID);
     event MultiSign(uint64 fromChainId, uint64 toChainId, string txHash, string payment, uint32 sequence);
     event RippleTx(uint64 fromChainId, uint64 toChainId, string txHash, string txJson, uint32 sequence);
+    event RippleTxQueued(uint64 fromChainId, uint64 toChainId, string txHash);
+    event RippleBatch(uint64 toChainId, uint64 batchId, string[] txJsons);
+    event RippleTxResult(uint64 fromChainId, uint64 toChainId, string txHash, string result);
 
     function name() external view returns(string memory Name);
     
     function importOuterTransfer(uint64 SourceChainID, uint32 Height, bytes memory Proof, bytes memory Extra, bytes memory Signature) external returns(bool success);
 
+    function importOuterTransfer64(uint64 SourceChainID, uint64 Height, bytes memory Proof, bytes memory Extra, bytes memory Signature) external returns(bool success);
+
     function multiSignRipple(uint64 ToChainId, bytes calldata AssetAddress, uint64 FromChainId, bytes calldata TxHash, string calldata TxJson) external returns(bool success);
 
+    function flushRippleBatch(uint64 ToChainId) external returns(bool success);
+
+    function multiSignRippleBatch(uint64 ToChainId, uint64 BatchId, string[] calldata TxJsons) external returns(bool success);
+
+    function confirmRippleTx(uint64 FromChainId, uint64 ToChainId, bytes calldata TxHash, string calldata Result, uint64 Delivered, uint64 Fee) external returns(bool success);
+
+    function getRippleTxStatus(uint64 FromChainId, uint64 ToChainId, bytes calldata TxHash) external view returns(uint8 status);
+
+    function getRippleVault(uint64 ChainId) external view returns(int256 balance, uint256 pending, uint256 deposited, uint256 withdrawn, uint256 fees, bool solvent);
+
+    function reconcileRippleVault(uint64 ChainId, uint64 Balance) external returns(bool success);
+
     function reconstructRippleTx(uint64 FromChainId, bytes calldata TxHash, uint64 ToChainId) external returns(bool success);
   
     function checkDone(uint64 chainID, bytes memory crossChainID) external view returns(bool success);
@@ -4,10 +4,29 @@ pragma experimental ABIEncoderV2;
 
 interface IInfoSync {
   event SyncRootInfoEvent(uint64 chainID, uint32 height, uint256 BlockHeight);
+  event SyncRootInfo64Event(uint64 chainID, uint64 height, uint256 BlockHeight);
   event ReplenishEvent(uint32[] heights, uint64 chainID);
+  event Replenish64Event(uint64[] heights, uint64 chainID);
+  event RootInfoConflict(uint64 chainID, uint64 height, address voter, bytes32 infoHash, bytes32 conflictHash);
+  event RetentionUpdated(uint64 chainID, uint64 window);
+  event RootInfoPinned(uint64 chainID, uint64 height, bool pinned);
+  event RootInfoPruned(uint64 chainID, uint64 prunedHeight);
   function name() external view returns(string memory);
   function syncRootInfo(uint64 chainID, bytes[] calldata rootInfos, bytes memory signature) external returns(bool);
+  function syncRootInfoAggregated(uint64 chainID, bytes[] calldata rootInfos, bytes[] calldata signatures) external returns(bool);
   function replenish(uint64 chainID, uint32[] calldata heights) external returns(bool);
+  function replenish64(uint64 chainID, uint64[] calldata heights) external returns(bool);
   function getInfoHeight(uint64 chainID) external view returns(uint32);
+  function getInfoHeight64(uint64 chainID) external view returns(uint64);
   function getInfo(uint64 chainID, uint32 height) external view returns(bytes memory);
-}
\ No newline at end of file
+  function getInfo64(uint64 chainID, uint64 height) external view returns(bytes memory);
+  function getStateRoot(uint64 chainID, uint64 height) external view returns(bytes32);
+  function getReceiptsRoot(uint64 chainID, uint64 height) external view returns(bytes32);
+  function getHeaderFields(uint64 chainID, uint64 height) external view returns(bytes32 stateRoot, bytes32 receiptsRoot, bytes32 transactionsRoot, bytes32 blockHash, uint64 timestamp);
+  function getInfoRange(uint64 chainID, uint64 from, uint64 to) external view returns(uint64[] memory heights, bytes[] memory infos);
+  function getMissingHeights(uint64 chainID, uint64 from, uint64 to) external view returns(uint64[] memory heights);
+  function getSyncProgress(uint64 chainID, uint64 height, bytes32 infoHash) external view returns(address[] memory voters, uint64 quorum);
+  function setRetention(uint64 chainID, uint64 window) external returns(bool);
+  function pinRootInfo(uint64 chainID, uint64 height, bool pinned) external returns(bool);
+  function getRetention(uint64 chainID) external view returns(uint64 window, uint64 prunedHeight);
+}
@@ -27,6 +27,8 @@ interface INodeManager {
     function getTotalPool() external view returns (bytes memory);
     function getOutstandingRewards() external view returns (bytes memory);
     function getStakeRewards(address consensusAddress, address stakeAddress) external view returns (bytes memory);
+    function getSignProgress(string calldata method, bytes32 inputHash) external view returns (bytes memory);
+    function getEvidence(address signer, address module, string calldata kind, uint64 height) external view returns (bytes memory);
 
     event CreateValidator(string consensusAddress, string caller, string amount);
     event UpdateValidator(string consensusAddress);
@@ -0,0 +1,27 @@
+pragma solidity >=0.7.0 <0.9.0;
+
+/**
+ * @dev Interface of the RelayerManager contract
+ */
+
+interface IRelayerManager {
+    event RelayerRegistered(address Relayer, uint256 Stake);
+    event RelayerUnregistered(address Relayer, uint64 UnbondHeight);
+    event StakeWithdrawn(address Relayer, uint256 Amount);
+    event RewardsDeposited(address Sender, uint256 Amount);
+    event RewardsWithdrawn(address Relayer, uint256 Amount);
+    event RelayerSlashed(address Relayer, uint256 Amount, bytes Evidence);
+    event RelayerParamsUpdated(uint256 MinStake, uint64 UnbondingPeriod, uint256 Reward, bool Whitelist);
+
+    function registerRelayer() external payable returns (bool success);
+    function unregisterRelayer() external returns (bool success);
+    function withdrawStake() external returns (bool success);
+    function depositRewards() external payable returns (bool success);
+    function withdrawRewards() external returns (bool success);
+    function slashRelayer(address relayer, uint256 amount, bytes calldata evidence) external returns (bool success);
+    function setRelayerParams(uint256 minStake, uint64 unbondingPeriod, uint256 reward, bool whitelist) external returns (bool success);
+
+    function getRelayer(address relayer) external view returns (bytes memory);
+    function getRelayerParams() external view returns (uint256 minStake, uint64 unbondingPeriod, uint256 reward, bool whitelist);
+    function getRewardPool() external view returns (uint256 amount);
+}
@@ -11,6 +11,20 @@ interface ISideChainManager {
     event ApproveUpdateSideChain(uint64 ChainId);
     event QuitSideChain(uint64 ChainId);
     event ApproveQuitSideChain(uint64 ChainId);
+    event CancelRequest(uint64 ChainId, uint8 RequestType);
+    event RejectRequest(uint64 ChainId, uint8 RequestType);
+    event RequestExpired(uint64 ChainId, uint8 RequestType);
+    event OwnershipTransferStarted(uint64 ChainId, address Owner, address NewOwner);
+    event OwnershipTransferred(uint64 ChainId, address PreviousOwner, address NewOwner);
+    event OwnershipRecovered(uint64 ChainId, address PreviousOwner, address NewOwner);
+    event AssetApproved(uint64 ChainId, bytes Asset, string Symbol, uint8 Decimals, uint8 Mode);
+    event AssetRemoved(uint64 ChainId, bytes Asset);
+    event SideChainStatusChanged(uint64 ChainId, uint8 PreviousStatus, uint8 Status);
+    event FeeUpdated(uint64 ChainId, uint64 View, uint256 Fee);
+    event FeeParamsUpdated(uint64 ChainId, uint64 Multiplier, uint256 MinFee, uint256 MaxFee, uint64 Timeout, bool StakeWeighted);
+    event BondParamsUpdated(uint256 RegisterBond, uint256 UpdateBond);
+    event BondRefunded(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount);
+    event BondForfeited(uint64 ChainId, uint8 RequestType, address Payer, uint256 Amount);
 
     struct SideChain {
         address owner;
@@ -21,13 +35,33 @@ interface ISideChainManager {
         bytes extraInfo;
     }
 
+    struct FeeRecord {
+        uint64 view;
+        uint256 fee;
+        uint64 height;
+    }
+
+    struct Asset {
+        uint64 chainID;
+        bytes asset;
+        string symbol;
+        uint8 decimals;
+        uint8 mode;
+    }
+
     function getSideChain(uint64 chainID) external view returns(SideChain memory sidechain);
+
+    function getSideChainAtHeight(uint64 chainID, uint64 height) external view returns(SideChain memory sidechain);
+
+    function getAllSideChains(uint64 offset, uint64 limit) external view returns(SideChain[] memory sidechains);
+
+    function getSideChainCount() external view returns(uint64 count);
     
-    function registerSideChain(uint64 chainID, uint64 router, string calldata name, bytes calldata CCMCAddress, bytes calldata extraInfo) external;
+    function registerSideChain(uint64 chainID, uint64 router, string calldata name, bytes calldata CCMCAddress, bytes calldata extraInfo) external payable;
     
     function approveRegisterSideChain(uint64 chainID) external returns (bool success);
     
-    function updateSideChain(uint64 chainID, uint64 router, string calldata name, bytes calldata CCMCAddress, bytes calldata extraInfo) external;
+    function updateSideChain(uint64 chainID, uint64 router, string calldata name, bytes calldata CCMCAddress, bytes calldata extraInfo, uint64 activationHeight) external payable;
     
     function approveUpdateSideChain(uint64 chainID) external returns (bool success);
     
@@ -35,9 +69,54 @@ interface ISideChainManager {
     
     function approveQuitSideChain(uint64 chainID) external returns (bool success);
 
+    function setSideChainStatus(uint64 chainID, uint8 status) external returns (bool success);
+
+    function getSideChainStatus(uint64 chainID) external view returns (uint8 status);
+
+    function getPendingRequest(uint64 chainID, uint8 requestType) external view returns(SideChain memory sidechain, uint64 expiry);
+
+    function getPendingRequests(uint8 requestType, uint64 offset, uint64 limit) external view returns(SideChain[] memory sidechains);
+
+    function cancelRequest(uint64 chainID, uint8 requestType) external returns (bool success);
+
+    function rejectRequest(uint64 chainID, uint8 requestType) external returns (bool success);
+
+    function setBondParams(uint256 registerBond, uint256 updateBond) external returns (bool success);
+
+    function getBondParams() external view returns (uint256 registerBond, uint256 updateBond);
+
+    function getRequestBond(uint64 chainID, uint8 requestType) external view returns (address payer, uint256 amount);
+
+    function transferOwnership(uint64 chainID, address newOwner) external returns (bool success);
+
+    function acceptOwnership(uint64 chainID) external returns (bool success);
+
+    function recoverOwnership(uint64 chainID, address newOwner) external returns (bool success);
+
+    function getPendingOwner(uint64 chainID) external view returns (address pendingOwner);
+
     function updateFee(uint64 chainID, uint64 viewNum, int fee, bytes calldata signature) external returns (bool success);
 
+    function setFeeParams(uint64 chainID, uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted) external returns (bool success);
+
+    function getFeeParams(uint64 chainID) external view returns (uint64 multiplier, uint256 minFee, uint256 maxFee, uint64 timeout, bool stakeWeighted);
+
+    function getFeeInfo(uint64 chainID, uint64 viewNum) external view returns (uint64 startHeight, address[] memory voters, uint256[] memory fees);
+
+    function getFeeHistory(uint64 chainID) external view returns (FeeRecord[] memory records);
+
+    // deprecated: ripple vault and lock proxy addresses only, assets are registered through approveAsset
     function registerAsset(uint64 chainID, uint64[] calldata AssetMapKey, bytes[] calldata AssetMapValue, uint64[] calldata LockProxyMapKey, bytes[] calldata LockProxyMapValue) external returns (bool success);
 
+    function approveAsset(uint64 chainID, bytes calldata asset, string calldata symbol, uint8 decimals, uint8 mode) external returns (bool success);
+
+    function removeAsset(uint64 chainID, bytes calldata asset) external returns (bool success);
+
+    function getAsset(uint64 chainID, bytes calldata asset) external view returns (Asset memory entry);
+
+    function getAssets(uint64 chainID, uint64 offset, uint64 limit) external view returns (Asset[] memory assets);
+
+    function getAssetCount(uint64 chainID) external view returns (uint64 count);
+
     function getFee(uint64 chainID) external view returns (bytes memory);
 }